@@ -4,12 +4,14 @@ import (
 	"fmt"
 	"os"
 	"runtime"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/leaanthony/slicer"
 	"github.com/pterm/pterm"
 	"github.com/wailsapp/wails/v2/cmd/wails/flags"
+	"github.com/wailsapp/wails/v2/cmd/wails/internal"
 	"github.com/wailsapp/wails/v2/cmd/wails/internal/gomod"
 	"github.com/wailsapp/wails/v2/internal/colour"
 	"github.com/wailsapp/wails/v2/internal/project"
@@ -70,11 +72,20 @@ func buildApplication(f *flags.Build) error {
 		UserTags:          f.GetTags(),
 		WebView2Strategy:  f.GetWebView2Strategy(),
 		TrimPath:          f.TrimPath,
+		Reproducible:      f.Reproducible,
+		SBOM:              f.SBOM,
+		SBOMFormat:        f.SBOMFormat,
+		Precompress:       f.Precompress,
+		Archive:           f.Archive,
 		RaceDetector:      f.RaceDetector,
 		WindowsConsole:    f.WindowsConsole,
 		Obfuscated:        f.Obfuscated,
 		GarbleArgs:        f.GarbleArgs,
 		SkipBindings:      f.SkipBindings,
+		Jobs:              f.Jobs,
+		FailFast:          f.FailFast,
+		NoCache:           f.NoCache,
+		WailsVersion:      internal.Version,
 		ProjectData:       projectOptions,
 	}
 
@@ -92,12 +103,19 @@ func buildApplication(f *flags.Build) error {
 	}
 	tableData = append(tableData, pterm.TableData{
 		{"Skip Frontend", bool2Str(f.SkipFrontend)},
+		{"Build Cache", bool2Str(!f.NoCache)},
 		{"Compress", bool2Str(f.Upx)},
 		{"Package", bool2Str(!f.NoPackage)},
 		{"Clean Bin Dir", bool2Str(f.Clean)},
 		{"LDFlags", f.LdFlags},
 		{"Tags", "[" + strings.Join(f.GetTags(), ",") + "]"},
 		{"Race Detector", bool2Str(f.RaceDetector)},
+		{"Reproducible", bool2Str(f.Reproducible)},
+		{"SBOM", bool2Str(f.SBOM)},
+		{"Precompress", bool2Str(f.Precompress || projectOptions.Precompress)},
+		{"Archive", bool2Str(f.Archive)},
+		{"Jobs", strconv.Itoa(f.Jobs)},
+		{"Fail Fast", bool2Str(f.FailFast)},
 	}...)
 	if len(buildOptions.OutputFile) > 0 && f.GetTargets().Length() == 1 {
 		tableData = append(tableData, []string{"Output File", f.OutputFilename})
@@ -132,19 +150,14 @@ func buildApplication(f *flags.Build) error {
 		"windows/386",
 	})
 
-	outputBinaries := map[string]string{}
-
-	// Allows cancelling the build after the first error. It would be nice if targets.Each would support funcs
-	// returning an error.
-	var targetErr error
+	var buildTargets []build.Target
+	var skipped []string
 	targets := f.GetTargets()
 	targets.Each(func(platform string) {
-		if targetErr != nil {
-			return
-		}
 
 		if !validPlatformArch.Contains(platform) {
 			buildOptions.Logger.Println("platform '%s' is not supported - skipping. Supported platforms: %s", platform, validPlatformArch.Join(","))
+			skipped = append(skipped, platform)
 			return
 		}
 
@@ -156,90 +169,105 @@ func buildApplication(f *flags.Build) error {
 
 		// Calculate platform and arch
 		platformSplit := strings.Split(platform, "/")
-		buildOptions.Platform = platformSplit[0]
-		buildOptions.Arch = f.GetDefaultArch()
+		target := build.Target{
+			Platform: platformSplit[0],
+			Arch:     f.GetDefaultArch(),
+		}
 		if len(platformSplit) > 1 {
-			buildOptions.Arch = platformSplit[1]
+			target.Arch = platformSplit[1]
 		}
-		banner := "Building target: " + buildOptions.Platform + "/" + buildOptions.Arch
-		pterm.DefaultSection.Println(banner)
 
 		if f.Upx && platform == "darwin/universal" {
 			pterm.Warning.Println("Warning: compress flag unsupported for universal binaries. Ignoring.")
 			f.Upx = false
+			buildOptions.Compress = false
 		}
 
-		switch buildOptions.Platform {
+		switch target.Platform {
 		case "linux":
 			if runtime.GOOS != "linux" {
 				pterm.Warning.Println("Crosscompiling to Linux not currently supported.")
+				skipped = append(skipped, target.String())
 				return
 			}
 		case "darwin":
 			if runtime.GOOS != "darwin" {
 				pterm.Warning.Println("Crosscompiling to Mac not currently supported.")
+				skipped = append(skipped, target.String())
 				return
 			}
-			macTargets := targets.Filter(func(platform string) bool {
-				return strings.HasPrefix(platform, "darwin")
-			})
-			if macTargets.Length() == 2 {
-				buildOptions.BundleName = fmt.Sprintf("%s-%s.app", desiredFilename, buildOptions.Arch)
-			}
 		}
 
-		if targets.Length() > 1 {
-			// target filename
-			switch buildOptions.Platform {
-			case "windows":
-				desiredFilename = fmt.Sprintf("%s-%s", desiredFilename, buildOptions.Arch)
-			case "linux", "darwin":
-				desiredFilename = fmt.Sprintf("%s-%s-%s", desiredFilename, buildOptions.Platform, buildOptions.Arch)
-			}
-		}
-		if buildOptions.Platform == "windows" {
+		if target.Platform == "windows" {
 			desiredFilename += ".exe"
 		}
-		buildOptions.OutputFile = desiredFilename
+		target.OutputFile = desiredFilename
 
 		if f.OutputFilename != "" {
-			buildOptions.OutputFile = f.OutputFilename
+			target.OutputFile = f.OutputFilename
 		}
 
-		if f.Obfuscated && f.SkipBindings {
-			pterm.Warning.Println("obfuscated flag overrides skipbindings flag.")
-			buildOptions.SkipBindings = false
-		}
+		buildTargets = append(buildTargets, target)
+	})
 
-		if !f.DryRun {
-			// Start Time
-			start := time.Now()
+	if f.Obfuscated && f.SkipBindings {
+		pterm.Warning.Println("obfuscated flag overrides skipbindings flag.")
+		buildOptions.SkipBindings = false
+	}
 
-			compiledBinary, err := build.Build(buildOptions)
-			if err != nil {
-				pterm.Error.Println(err.Error())
-				targetErr = err
-				return
-			}
+	if f.DryRun {
+		for _, target := range buildTargets {
+			pterm.Info.Println("Dry run: skipped build of " + target.String() + ".")
+		}
+		return nil
+	}
+
+	if len(buildTargets) == 0 {
+		return nil
+	}
 
-			buildOptions.IgnoreFrontend = true
-			buildOptions.CleanBinDirectory = false
+	var banner string
+	if len(buildTargets) == 1 {
+		banner = "Building target: " + buildTargets[0].String()
+	} else {
+		banner = fmt.Sprintf("Building %d targets (jobs: %d)", len(buildTargets), f.Jobs)
+	}
+	pterm.DefaultSection.Println(banner)
 
-			// Output stats
-			buildOptions.Logger.Println(fmt.Sprintf("Built '%s' in %s.\n", compiledBinary, time.Since(start).Round(time.Millisecond).String()))
+	results, buildErr := build.BuildTargets(buildOptions, buildTargets)
 
-			outputBinaries[buildOptions.Platform+"/"+buildOptions.Arch] = compiledBinary
-		} else {
-			pterm.Info.Println("Dry run: skipped build.")
+	outputBinaries := map[string]string{}
+	summary := pterm.TableData{
+		{"Target", "Status", "Size", "Duration", "Output"},
+	}
+	for _, result := range results {
+		row := []string{result.Target.String(), string(result.Status), "", "", ""}
+		switch result.Status {
+		case build.TargetSucceeded:
+			outputBinaries[result.Target.String()] = result.Binary
+			row[2] = formatSize(result.Size)
+			row[3] = result.Duration.Round(time.Millisecond).String()
+			row[4] = result.Binary
+		case build.TargetFailed:
+			row[3] = result.Duration.Round(time.Millisecond).String()
+			row[4] = result.Err.Error()
 		}
-	})
+		summary = append(summary, row)
+	}
+	for _, platform := range skipped {
+		summary = append(summary, []string{platform, "skipped", "", "", ""})
+	}
 
-	if targetErr != nil {
-		return targetErr
+	if len(results) > 0 {
+		pterm.DefaultSection.Println("Build Summary")
+		err = pterm.DefaultTable.WithHasHeader().WithData(summary).Render()
+		if err != nil {
+			return err
+		}
 	}
 
-	if f.DryRun {
-		return nil
+	if buildErr != nil {
+		return buildErr
 	}
 
 	if f.NSIS {
@@ -257,3 +285,16 @@ func buildApplication(f *flags.Build) error {
 	return nil
 
 }
+
+func formatSize(size int64) string {
+	const unit = 1024
+	if size < unit {
+		return fmt.Sprintf("%d B", size)
+	}
+	div, exp := int64(unit), 0
+	for n := size / unit; n >= unit; n /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %ciB", float64(size)/float64(div), "KMGTPE"[exp])
+}
@@ -0,0 +1,44 @@
+package main
+
+import (
+	"os"
+
+	"github.com/pterm/pterm"
+	"github.com/wailsapp/wails/v2/cmd/wails/flags"
+	"github.com/wailsapp/wails/v2/internal/colour"
+	"github.com/wailsapp/wails/v2/internal/fs"
+	"github.com/wailsapp/wails/v2/internal/project"
+	"github.com/wailsapp/wails/v2/pkg/commands/build"
+)
+
+func cleanCache(f *flags.CacheClean) error {
+	if f.NoColour {
+		pterm.DisableColor()
+		colour.ColourEnabled = false
+	}
+
+	app.PrintBanner()
+
+	cwd, err := os.Getwd()
+	if err != nil {
+		return err
+	}
+	projectOptions, err := project.Load(cwd)
+	if err != nil {
+		return err
+	}
+
+	cacheDir := build.CacheDir(projectOptions)
+	if !fs.DirExists(cacheDir) {
+		pterm.Info.Println("No build cache found.")
+		return nil
+	}
+
+	err = build.CleanCache(projectOptions)
+	if err != nil {
+		return err
+	}
+
+	pterm.Success.Println("Removed build cache: " + cacheDir)
+	return nil
+}
@@ -38,10 +38,17 @@ type Build struct {
 	Devtools                bool   `description:"Enable Devtools in productions, Already enabled in debug mode (-debug)"`
 	NSIS                    bool   `description:"Generate NSIS installer for Windows"`
 	TrimPath                bool   `description:"Remove all file system paths from the resulting executable"`
+	Reproducible            bool   `description:"Produce a reproducible build. Requires SOURCE_DATE_EPOCH or a git repository"`
+	SBOM                    bool   `name:"sbom" description:"Generate a software bill of materials and third-party license notice"`
+	SBOMFormat              string `name:"sbomformat" description:"Format of the software bill of materials: cyclonedx, spdx"`
+	Precompress             bool   `description:"Write gzip and brotli compressed copies of the frontend assets"`
+	Archive                 bool   `description:"Create a zip archive of each built application"`
 	WindowsConsole          bool   `description:"Keep the console when building for Windows"`
 	Obfuscated              bool   `description:"Code obfuscation of bound Wails methods"`
 	GarbleArgs              string `description:"Arguments to pass to garble"`
 	DryRun                  bool   `description:"Prints the build command without executing it"`
+	Jobs                    int    `description:"Number of targets to compile concurrently"`
+	FailFast                bool   `description:"Stop building the remaining targets after the first failure"`
 
 	// Build Specific
 
@@ -70,6 +77,7 @@ func (b *Build) Default() *Build {
 		Platform:   defaultPlatform + "/" + defaultArch,
 		WebView2:   "download",
 		GarbleArgs: "-literals -tiny -seed=random",
+		Jobs:       runtime.NumCPU(),
 
 		defaultArch: defaultArch,
 	}
@@ -9,6 +9,7 @@ type BuildCommon struct {
 	Verbosity    int    `name:"v" description:"Verbosity level (0 = quiet, 1 = normal, 2 = verbose)"`
 	Tags         string `description:"Build tags to pass to Go compiler. Must be quoted. Space or comma (but not both) separated"`
 	NoSyncGoMod  bool   `description:"Don't sync go.mod"`
+	NoCache      bool   `name:"no-cache" description:"Ignore the build cache and run every build step"`
 }
 
 func (c BuildCommon) Default() BuildCommon {
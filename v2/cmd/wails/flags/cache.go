@@ -0,0 +1,5 @@
+package flags
+
+type CacheClean struct {
+	Common
+}
@@ -133,6 +133,7 @@ func (d *Dev) GenerateBuildOptions() *build.Options {
 		Verbosity:      d.Verbosity,
 		WailsJSDir:     d.WailsJSDir,
 		RaceDetector:   d.RaceDetector,
+		NoCache:        d.NoCache,
 		ProjectData:    d.projectConfig,
 	}
 
@@ -12,3 +12,11 @@ type GenerateTemplate struct {
 	Frontend string `description:"Frontend to use for the template"`
 	Quiet    bool   `description:"Suppress output"`
 }
+
+type GenerateSBOM struct {
+	Common
+	Format string `description:"Format of the software bill of materials: cyclonedx, spdx"`
+	Binary string `description:"Read the Go modules from the given application binary instead of go.sum"`
+	Output string `name:"o" description:"Output filename. Defaults to <name>.cdx.json or <name>.spdx.json"`
+	Notice string `description:"Also write the third-party license notice to the given filename"`
+}
@@ -4,3 +4,12 @@ type ShowReleaseNotes struct {
 	Common
 	Version string `description:"The version to show the release notes for"`
 }
+
+type ShowBuildInfo struct {
+	Common
+}
+
+type ShowKeymap struct {
+	Common
+	Tags string `description:"Build tags to pass to Go compiler. Must be quoted. Space or comma (but not both) separated"`
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/pterm/pterm"
 	"github.com/tidwall/sjson"
 	"github.com/wailsapp/wails/v2/cmd/wails/flags"
+	"github.com/wailsapp/wails/v2/cmd/wails/internal"
 	"github.com/wailsapp/wails/v2/cmd/wails/internal/template"
 	"github.com/wailsapp/wails/v2/internal/colour"
 	"github.com/wailsapp/wails/v2/internal/fs"
@@ -14,6 +15,7 @@ import (
 	"github.com/wailsapp/wails/v2/pkg/clilogger"
 	"github.com/wailsapp/wails/v2/pkg/commands/bindings"
 	"github.com/wailsapp/wails/v2/pkg/commands/buildtags"
+	"github.com/wailsapp/wails/v2/pkg/commands/sbom"
 	"os"
 	"path/filepath"
 )
@@ -54,6 +56,82 @@ func generateModule(f *flags.GenerateModule) error {
 	return nil
 }
 
+func generateSBOM(f *flags.GenerateSBOM) error {
+
+	if f.NoColour {
+		pterm.DisableColor()
+		colour.ColourEnabled = false
+	}
+
+	app.PrintBanner()
+
+	cwd, err := os.Getwd()
+	if err != nil {
+		return err
+	}
+	projectConfig, err := project.Load(cwd)
+	if err != nil {
+		return err
+	}
+
+	formatName := f.Format
+	if formatName == "" {
+		formatName = projectConfig.SBOM.Format
+	}
+	format, err := sbom.ParseFormat(formatName)
+	if err != nil {
+		return err
+	}
+
+	binary := f.Binary
+	if binary != "" {
+		binary, err = filepath.Abs(binary)
+		if err != nil {
+			return err
+		}
+	}
+
+	report, err := sbom.Generate(sbom.Options{
+		AppName:           projectConfig.Name,
+		AppVersion:        projectConfig.Info.ProductVersion,
+		ProjectDirectory:  projectConfig.Path,
+		FrontendDirectory: projectConfig.GetFrontendDir(),
+		Binary:            binary,
+		WailsVersion:      internal.Version,
+	})
+	if err != nil {
+		return err
+	}
+	for _, warning := range report.Warnings {
+		pterm.Warning.Println(warning)
+	}
+
+	output := f.Output
+	if output == "" {
+		output = projectConfig.Name + format.Extension()
+	}
+	err = report.WriteFile(output, format)
+	if err != nil {
+		return err
+	}
+	pterm.Success.Printf("Wrote bill of materials with %d components to %s\n", len(report.Components), output)
+
+	if f.Notice != "" {
+		err = report.WriteNoticeFile(f.Notice)
+		if err != nil {
+			return err
+		}
+		pterm.Success.Println("Wrote license notice to " + f.Notice)
+	}
+
+	err = report.CheckLicenses(projectConfig.SBOM.DisallowedLicenses)
+	if err != nil && !projectConfig.SBOM.FailOnDisallowedLicense {
+		pterm.Warning.Println(err.Error())
+		return nil
+	}
+	return err
+}
+
 func generateTemplate(f *flags.GenerateTemplate) error {
 
 	if f.NoColour {
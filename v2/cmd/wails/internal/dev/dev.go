@@ -21,6 +21,7 @@ import (
 
 	"github.com/samber/lo"
 	"github.com/wailsapp/wails/v2/cmd/wails/flags"
+	"github.com/wailsapp/wails/v2/cmd/wails/internal"
 	"github.com/wailsapp/wails/v2/cmd/wails/internal/gomod"
 	"github.com/wailsapp/wails/v2/cmd/wails/internal/logutils"
 	"golang.org/x/mod/semver"
@@ -69,6 +70,7 @@ func Application(f *flags.Dev, logger *clilogger.CLILogger) error {
 
 	buildOptions := f.GenerateBuildOptions()
 	buildOptions.Logger = logger
+	buildOptions.WailsVersion = internal.Version
 
 	userTags, err := buildtags.Parse(f.Tags)
 	if err != nil {
@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	"github.com/pterm/pterm"
+	"github.com/wailsapp/wails/v2/cmd/wails/flags"
 	"github.com/wailsapp/wails/v2/cmd/wails/internal"
 
 	"github.com/wailsapp/wails/v2/internal/colour"
@@ -82,10 +83,19 @@ func main() {
 
 	show := app.NewSubCommand("show", "Shows various information")
 	show.NewSubCommandFunction("releasenotes", "Shows the release notes for the current version", showReleaseNotes)
+	var buildInfoCommand *clir.Command
+	buildInfoCommand = show.NewSubCommandFunction("buildinfo", "Shows the build information embedded in an application binary", func(f *flags.ShowBuildInfo) error {
+		return showBuildInfo(f, buildInfoCommand.OtherArgs())
+	})
+	show.NewSubCommandFunction("keymap", "Shows the accelerators of the menus of the current project", showKeymap)
+
+	cache := app.NewSubCommand("cache", "Manages the build cache")
+	cache.NewSubCommandFunction("clean", "Removes the build cache of the current project", cleanCache)
 
 	generate := app.NewSubCommand("generate", "Code Generation Tools")
 	generate.NewSubCommandFunction("module", "Generates a new Wails module", generateModule)
 	generate.NewSubCommandFunction("template", "Generates a new Wails template", generateTemplate)
+	generate.NewSubCommandFunction("sbom", "Generates a software bill of materials for the project", generateSBOM)
 
 	command := app.NewSubCommand("version", "The Wails CLI version")
 	command.Action(func() error {
@@ -1,11 +1,20 @@
 package main
 
 import (
+	debugbuildinfo "debug/buildinfo"
+	"fmt"
+	"os"
+	"strings"
+
 	"github.com/pterm/pterm"
 	"github.com/wailsapp/wails/v2/cmd/wails/flags"
 	"github.com/wailsapp/wails/v2/cmd/wails/internal"
+	"github.com/wailsapp/wails/v2/internal/buildinfo"
 	"github.com/wailsapp/wails/v2/internal/colour"
 	"github.com/wailsapp/wails/v2/internal/github"
+	"github.com/wailsapp/wails/v2/internal/project"
+	"github.com/wailsapp/wails/v2/pkg/commands/buildtags"
+	"github.com/wailsapp/wails/v2/pkg/commands/keymap"
 )
 
 func showReleaseNotes(f *flags.ShowReleaseNotes) error {
@@ -25,3 +34,105 @@ func showReleaseNotes(f *flags.ShowReleaseNotes) error {
 
 	return nil
 }
+
+func showBuildInfo(f *flags.ShowBuildInfo, args []string) error {
+	if f.NoColour {
+		pterm.DisableColor()
+		colour.ColourEnabled = false
+	}
+
+	if len(args) == 0 {
+		return fmt.Errorf("please provide the path to an application binary: wails show buildinfo <binary>")
+	}
+	binary := args[0]
+
+	app.PrintBanner()
+
+	info, err := buildinfo.ReadFile(binary)
+	if err != nil {
+		return err
+	}
+
+	tableData := pterm.TableData{
+		{"App Version", info.AppVersion},
+		{"Git Commit", info.GitCommit},
+		{"Build Time", info.BuildTime},
+		{"Wails Version", info.WailsVersion},
+		{"Tags", "[" + strings.Join(info.Tags, ",") + "]"},
+		{"Reproducible", bool2Str(info.Reproducible)},
+	}
+
+	// Add the information recorded by the Go toolchain
+	if goInfo, err := debugbuildinfo.ReadFile(binary); err == nil {
+		tableData = append(tableData, []string{"Go Version", goInfo.GoVersion})
+		for _, setting := range goInfo.Settings {
+			if strings.HasPrefix(setting.Key, "vcs") || setting.Key == "-trimpath" {
+				tableData = append(tableData, []string{setting.Key, setting.Value})
+			}
+		}
+	}
+
+	pterm.DefaultSection.Println("Build Information")
+	return pterm.DefaultTable.WithData(tableData).Render()
+}
+
+func showKeymap(f *flags.ShowKeymap) error {
+	if f.NoColour {
+		pterm.DisableColor()
+		colour.ColourEnabled = false
+	}
+
+	buildTags, err := buildtags.Parse(f.Tags)
+	if err != nil {
+		return err
+	}
+
+	cwd, err := os.Getwd()
+	if err != nil {
+		return err
+	}
+	if _, err := project.Load(cwd); err != nil {
+		return err
+	}
+
+	app.PrintBanner()
+
+	sections, err := keymap.Generate(keymap.Options{
+		Tags:             buildTags,
+		ProjectDirectory: cwd,
+	})
+	if err != nil {
+		return err
+	}
+	if len(sections) == 0 {
+		pterm.Println("No menus are given in the application options")
+		return nil
+	}
+
+	for _, section := range sections {
+		pterm.DefaultSection.Println(section.Menu)
+		if len(section.Entries) == 0 {
+			pterm.Println("No accelerators")
+			continue
+		}
+		tableData := pterm.TableData{
+			{"Menu Item", "Mac", "Linux", "Windows"},
+		}
+		for _, entry := range section.Entries {
+			tableData = append(tableData, []string{
+				strings.Join(entry.Path, " > "),
+				entry.Accelerators["darwin"],
+				entry.Accelerators["linux"],
+				entry.Accelerators["windows"],
+			})
+		}
+		if err := pterm.DefaultTable.WithHasHeader().WithData(tableData).Render(); err != nil {
+			return err
+		}
+		for _, conflict := range section.Conflicts {
+			pterm.Warning.Println(conflict)
+		}
+	}
+
+	return nil
+}
@@ -3,9 +3,11 @@ package app
 import (
 	"context"
 
+	"github.com/wailsapp/wails/v2/internal/crashreporter"
 	"github.com/wailsapp/wails/v2/internal/frontend"
 	"github.com/wailsapp/wails/v2/internal/logger"
 	"github.com/wailsapp/wails/v2/internal/menumanager"
+	"github.com/wailsapp/wails/v2/internal/windowstate"
 	"github.com/wailsapp/wails/v2/pkg/menu"
 	"github.com/wailsapp/wails/v2/pkg/options"
 )
@@ -18,6 +20,12 @@ type App struct {
 
 	menuManager *menumanager.Manager
 
+	// Writes crash reports. May be nil
+	crashReporter *crashreporter.Reporter
+
+	// Restores and saves the window state. May be nil
+	windowState *windowstate.Manager
+
 	// Indicates if the app is in debug mode
 	debug bool
 
@@ -39,6 +47,11 @@ func (a *App) Shutdown() {
 
 // SetApplicationMenu sets the application menu
 func (a *App) SetApplicationMenu(menu *menu.Menu) {
+	if a.menuManager != nil {
+		if err := a.menuManager.SetApplicationMenu(menu); err != nil {
+			a.logger.Error("unable to process the application menu: %s", err)
+		}
+	}
 	if a.frontend != nil {
 		a.frontend.MenuSetApplicationMenu(menu)
 	}
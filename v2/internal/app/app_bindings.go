@@ -13,11 +13,17 @@ import (
 	"github.com/wailsapp/wails/v2/internal/fs"
 	"github.com/wailsapp/wails/v2/internal/logger"
 	"github.com/wailsapp/wails/v2/internal/project"
+	"github.com/wailsapp/wails/v2/pkg/commands/keymap"
 	"github.com/wailsapp/wails/v2/pkg/options"
 )
 
 func (a *App) Run() error {
 
+	// `wails show keymap` runs the application to read the accelerators of its menus
+	if keymapFile := keymap.Requested(); keymapFile != "" {
+		return keymap.WriteFile(keymapFile, keymap.NewSections(a.options.Menu, a.options.ContextMenus))
+	}
+
 	// Create binding exemptions - Ugly hack. There must be a better way
 	bindingExemptions := []interface{}{
 		a.options.OnStartup,
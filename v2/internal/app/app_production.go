@@ -6,21 +6,29 @@ import (
 	"context"
 
 	"github.com/wailsapp/wails/v2/internal/binding"
+	"github.com/wailsapp/wails/v2/internal/crashreporter"
 	"github.com/wailsapp/wails/v2/internal/frontend/desktop"
 	"github.com/wailsapp/wails/v2/internal/frontend/dispatcher"
 	"github.com/wailsapp/wails/v2/internal/frontend/runtime"
+	"github.com/wailsapp/wails/v2/internal/keybindings"
 	"github.com/wailsapp/wails/v2/internal/logger"
 	"github.com/wailsapp/wails/v2/internal/menumanager"
+	"github.com/wailsapp/wails/v2/internal/windowstate"
+	"github.com/wailsapp/wails/v2/pkg/assetserver"
+	"github.com/wailsapp/wails/v2/pkg/crashreport"
 	"github.com/wailsapp/wails/v2/pkg/options"
 )
 
 func (a *App) Run() error {
+	defer a.crashReporter.Recover(crashreport.SourceMain)
 	err := a.frontend.Run(a.ctx)
 	a.frontend.RunMainLoop()
+	a.windowState.Save()
 	a.frontend.WindowClose()
 	if a.shutdownCallback != nil {
 		a.shutdownCallback(a.ctx)
 	}
+	a.logger.Flush()
 	return err
 }
 
@@ -40,6 +48,9 @@ func CreateApp(appoptions *options.App) (*App, error) {
 
 	// Set up logger
 	myLogger := logger.New(appoptions.Logger)
+	if appoptions.SlogHandler != nil {
+		myLogger.SetHandler(appoptions.SlogHandler)
+	}
 	if IsDebug() {
 		myLogger.SetLogLevel(appoptions.LogLevel)
 	} else {
@@ -56,6 +67,8 @@ func CreateApp(appoptions *options.App) (*App, error) {
 
 	// Create the menu manager
 	menuManager := menumanager.NewManager()
+	menuManager.SetLogger(myLogger.Structured().With("component", "MenuManager"))
+	menuManager.SetHideWindowOnClose(appoptions.HideWindowOnClose)
 
 	// Process the application menu
 	if appoptions.Menu != nil {
@@ -65,6 +78,17 @@ func CreateApp(appoptions *options.App) (*App, error) {
 		}
 	}
 
+	// Process the context menus
+	for _, contextMenu := range appoptions.ContextMenus {
+		menuManager.AddContextMenu(contextMenu)
+	}
+	ctx = context.WithValue(ctx, "menumanager", menuManager)
+
+	// Warn about accelerators that shadow each other
+	if debug {
+		menuManager.ValidateAccelerators()
+	}
+
 	// Create binding exemptions - Ugly hack. There must be a better way
 	bindingExemptions := []interface{}{
 		appoptions.OnStartup,
@@ -74,24 +98,71 @@ func CreateApp(appoptions *options.App) (*App, error) {
 	}
 	appBindings := binding.NewBindings(myLogger, appoptions.Bind, bindingExemptions, IsObfuscated())
 	eventHandler := runtime.NewEvents(myLogger)
+
+	// Crash reporting
+	var crashReporter *crashreporter.Reporter
+	if appoptions.CrashReporter != nil {
+		crashReporter, err = crashreporter.New(appoptions.Title, appoptions.CrashReporter, myLogger)
+		if err != nil {
+			return nil, err
+		}
+		eventHandler.SetCrashReporter(crashReporter)
+		ctx = context.WithValue(ctx, "crashreporter", crashReporter)
+		appoptions.OnDomReady = crashReporter.WrapDomReady(appoptions.OnDomReady)
+	}
+
+	// Window state
+	var windowState *windowstate.Manager
+	if appoptions.WindowState != nil && appoptions.WindowState.Persist {
+		windowState, err = windowstate.New(appoptions.WindowState, myLogger)
+		if err != nil {
+			return nil, err
+		}
+		windowState.Apply(appoptions)
+		appoptions.OnStartup = windowState.WrapStartup(appoptions.OnStartup)
+	}
 	ctx = context.WithValue(ctx, "events", eventHandler)
+	menuManager.SetEvents(eventHandler)
+
+	// Key bindings
+	keyBindings := keybindings.NewManager(eventHandler)
+	for accelerator, callback := range appoptions.KeyBindings {
+		err = keyBindings.Add(&keybindings.KeyBinding{
+			Accelerator:    accelerator,
+			Callback:       callback,
+			PreventDefault: true,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	ctx = context.WithValue(ctx, "keybindings", keyBindings)
+
 	// Attach logger to context
 	if debug {
 		ctx = context.WithValue(ctx, "buildtype", "debug")
 	} else {
 		ctx = context.WithValue(ctx, "buildtype", "production")
 	}
+	ctx = context.WithValue(ctx, "frontendLogCapture", appoptions.CaptureFrontendLogs)
+	ctx = context.WithValue(ctx, "signedfileurls", appoptions.AssetServer != nil && appoptions.AssetServer.SignedFileURLs)
+	if debug {
+		ctx = context.WithValue(ctx, "assetinspector", assetserver.EnableInspector())
+	}
 
 	messageDispatcher := dispatcher.NewDispatcher(ctx, myLogger, appBindings, eventHandler, appoptions.ErrorFormatter)
 	appFrontend := desktop.NewFrontend(ctx, appoptions, myLogger, appBindings, messageDispatcher)
 	eventHandler.AddFrontend(appFrontend)
 
 	ctx = context.WithValue(ctx, "frontend", appFrontend)
+	keyBindings.SetContext(ctx)
 	result := &App{
 		ctx:              ctx,
 		frontend:         appFrontend,
 		logger:           myLogger,
 		menuManager:      menuManager,
+		crashReporter:    crashReporter,
+		windowState:      windowState,
 		startupCallback:  appoptions.OnStartup,
 		shutdownCallback: appoptions.OnShutdown,
 		debug:            debug,
@@ -3,6 +3,7 @@ package binding
 import (
 	"bufio"
 	"bytes"
+	"context"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -15,11 +16,13 @@ import (
 
 	"github.com/leaanthony/slicer"
 	"github.com/wailsapp/wails/v2/internal/logger"
+	pkgLogger "github.com/wailsapp/wails/v2/pkg/logger"
 )
 
 type Bindings struct {
 	db         *DB
 	logger     logger.CustomLogger
+	structured *logger.Structured
 	exemptions slicer.StringSlicer
 
 	structsToGenerateTS map[string]map[string]interface{}
@@ -30,9 +33,11 @@ type Bindings struct {
 
 // NewBindings returns a new Bindings object
 func NewBindings(logger *logger.Logger, structPointersToBind []interface{}, exemptions []interface{}, obfuscate bool) *Bindings {
+	bindingsLogger := logger.CustomLogger("Bindings")
 	result := &Bindings{
 		db:                  newDB(),
-		logger:              logger.CustomLogger("Bindings"),
+		logger:              bindingsLogger,
+		structured:          bindingsLogger.Structured(),
 		structsToGenerateTS: make(map[string]map[string]interface{}),
 		obfuscate:           obfuscate,
 	}
@@ -74,6 +79,7 @@ func (b *Bindings) Add(structPtr interface{}) error {
 
 		// Add it as a regular method
 		b.db.AddMethod(packageName, structName, methodName, method)
+		b.structured.Log(context.Background(), pkgLogger.TRACE, "method bound", "method", method.Name, "inputs", len(method.Inputs), "outputs", len(method.Outputs))
 	}
 	return nil
 }
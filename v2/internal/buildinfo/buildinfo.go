@@ -0,0 +1,103 @@
+package buildinfo
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"os"
+	"strings"
+	"sync"
+)
+
+// Variable is the fully qualified name of the variable the Wails CLI sets using `-ldflags -X`
+const Variable = "github.com/wailsapp/wails/v2/internal/buildinfo.encoded"
+
+// prefix marks the start of the encoded build information in a compiled binary.
+// The ':' is not part of the base64 alphabet, so it also terminates the data.
+const prefix = "wails:buildinfo:"
+
+// Info holds the build information embedded into an application by the Wails CLI
+type Info struct {
+	AppVersion   string   `json:"appVersion"`
+	GitCommit    string   `json:"gitCommit"`
+	BuildTime    string   `json:"buildTime"`
+	WailsVersion string   `json:"wailsVersion"`
+	Tags         []string `json:"tags"`
+	Reproducible bool     `json:"reproducible"`
+}
+
+// encoded is set at build time by the Wails CLI
+var encoded string
+
+var (
+	current     Info
+	currentOnce sync.Once
+)
+
+// Get returns the build information of the running application. An empty Info is
+// returned if the application was not built with the Wails CLI.
+func Get() Info {
+	currentOnce.Do(func() {
+		current, _ = Decode(encoded)
+	})
+	return current
+}
+
+// Encode returns the value to assign to Variable for the given build information
+func Encode(info Info) (string, error) {
+	data, err := json.Marshal(info)
+	if err != nil {
+		return "", err
+	}
+	return prefix + base64.RawURLEncoding.EncodeToString(data) + ":", nil
+}
+
+// Decode parses a value created by Encode
+func Decode(value string) (Info, error) {
+	var result Info
+	if !strings.HasPrefix(value, prefix) || !strings.HasSuffix(value, ":") {
+		return result, errors.New("no build information found")
+	}
+	data, err := base64.RawURLEncoding.DecodeString(value[len(prefix) : len(value)-1])
+	if err != nil {
+		return result, err
+	}
+	err = json.Unmarshal(data, &result)
+	return result, err
+}
+
+// ReadFile reads the build information from the given application binary
+func ReadFile(filename string) (Info, error) {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return Info{}, err
+	}
+
+	marker := []byte(prefix)
+	for offset := 0; ; {
+		index := bytes.Index(data[offset:], marker)
+		if index == -1 {
+			break
+		}
+		start := offset + index
+		end := start + len(marker)
+		for end < len(data) && isBase64URL(data[end]) {
+			end++
+		}
+		if end < len(data) && data[end] == ':' {
+			// The marker also appears as a constant in the binary, so only
+			// a value that decodes correctly is accepted
+			if info, err := Decode(string(data[start : end+1])); err == nil {
+				return info, nil
+			}
+		}
+		offset = start + len(marker)
+	}
+
+	return Info{}, errors.New("no build information found, was the binary built with the Wails CLI?")
+}
+
+func isBase64URL(c byte) bool {
+	return (c >= 'A' && c <= 'Z') || (c >= 'a' && c <= 'z') || (c >= '0' && c <= '9') || c == '-' || c == '_'
+}
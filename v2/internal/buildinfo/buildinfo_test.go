@@ -0,0 +1,51 @@
+package buildinfo
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/matryer/is"
+)
+
+func TestEncodeDecode(t *testing.T) {
+	is2 := is.New(t)
+
+	info := Info{
+		AppVersion:   "1.0.0",
+		GitCommit:    "c0f3ca285457b08ce51e297888c9b1142a132e63",
+		BuildTime:    "2023-01-01T00:00:00Z",
+		WailsVersion: "v2.6.0",
+		Tags:         []string{"desktop", "production"},
+		Reproducible: true,
+	}
+	encoded, err := Encode(info)
+	is2.NoErr(err)
+
+	decoded, err := Decode(encoded)
+	is2.NoErr(err)
+	is2.Equal(decoded, info)
+
+	_, err = Decode("")
+	is2.True(err != nil)
+}
+
+func TestReadFile(t *testing.T) {
+	is2 := is.New(t)
+
+	encoded, err := Encode(Info{AppVersion: "1.2.3"})
+	is2.NoErr(err)
+
+	// The marker on its own, as found in the string table of every binary, must be skipped
+	binary := filepath.Join(t.TempDir(), "app")
+	content := "\x00" + prefix + "\x00garbage\x00" + encoded + "\x00"
+	is2.NoErr(os.WriteFile(binary, []byte(content), 0644))
+
+	info, err := ReadFile(binary)
+	is2.NoErr(err)
+	is2.Equal(info.AppVersion, "1.2.3")
+
+	is2.NoErr(os.WriteFile(binary, []byte(prefix), 0644))
+	_, err = ReadFile(binary)
+	is2.True(err != nil)
+}
@@ -0,0 +1,213 @@
+package crashreporter
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"path/filepath"
+	goruntime "runtime"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/wailsapp/wails/v2/internal/buildinfo"
+	"github.com/wailsapp/wails/v2/internal/fs"
+	"github.com/wailsapp/wails/v2/internal/logger"
+	"github.com/wailsapp/wails/v2/internal/system/operatingsystem"
+	"github.com/wailsapp/wails/v2/pkg/crashreport"
+	pkgLogger "github.com/wailsapp/wails/v2/pkg/logger"
+	"github.com/wailsapp/wails/v2/pkg/options"
+	"github.com/wailsapp/wails/v2/pkg/runtime"
+)
+
+const defaultRecentLogs = 200
+
+// Reporter writes crash reports and shows the reports of previous crashes on the next launch.
+// A nil Reporter does nothing.
+type Reporter struct {
+	appName string
+	options options.CrashReporter
+	logger  *logger.Logger
+
+	showPending sync.Once
+}
+
+// New creates a Reporter for the given application. The logger keeps the most recent
+// logs in memory so that they can be included in reports.
+func New(appName string, reporterOptions *options.CrashReporter, log *logger.Logger) (*Reporter, error) {
+	result := &Reporter{
+		appName: appName,
+		options: *reporterOptions,
+		logger:  log,
+	}
+	if result.appName == "" {
+		result.appName = fs.ExecutableName()
+	}
+	if result.options.Dir == "" {
+		logDir, err := pkgLogger.DefaultLogDirectory(fs.ExecutableName())
+		if err != nil {
+			return nil, err
+		}
+		result.options.Dir = filepath.Join(logDir, "crashes")
+	}
+	if result.options.RecentLogs <= 0 {
+		result.options.RecentLogs = defaultRecentLogs
+	}
+	log.KeepRecent(result.options.RecentLogs)
+	return result, nil
+}
+
+// Recover writes a crash report if the current goroutine is panicking and then
+// continues panicking. It must be deferred directly, EG: `defer reporter.Recover(crashreport.SourceMain)`
+func (r *Reporter) Recover(source string) {
+	if r == nil {
+		return
+	}
+	value := recover()
+	if value == nil {
+		return
+	}
+	r.ReportCrash(source, fmt.Sprint(value))
+	panic(value)
+}
+
+// ReportCrash writes a crash report with the stack traces of all goroutines and
+// calls the OnCrash handler
+func (r *Reporter) ReportCrash(source string, message string) {
+	if r == nil {
+		return
+	}
+	report := crashreport.Report{
+		ID:         time.Now().UTC().Format("20060102-150405") + "-" + uuid.NewString()[:8],
+		Time:       time.Now(),
+		AppName:    r.appName,
+		Source:     source,
+		Message:    message,
+		Build:      buildinfo.Get(),
+		OS:         osInfo(),
+		Goroutines: goroutines(),
+		Logs:       r.logger.Recent(),
+	}
+
+	if err := report.Write(r.options.Dir); err != nil {
+		r.logger.Error("Unable to write crash report: %s", err.Error())
+	} else {
+		r.logger.Error("Crash report written to %s", report.Dir)
+	}
+	r.logger.Flush()
+
+	if r.options.OnCrash != nil {
+		r.callOnCrash(report)
+	}
+}
+
+func (r *Reporter) callOnCrash(report crashreport.Report) {
+	defer func() {
+		if value := recover(); value != nil {
+			r.logger.Error("Crash handler panicked: %v", value)
+		}
+	}()
+	r.options.OnCrash(report)
+}
+
+// WrapDomReady returns an OnDomReady callback that calls the given callback and then
+// offers to show or send the reports of previous crashes, once per launch
+func (r *Reporter) WrapDomReady(callback func(ctx context.Context)) func(ctx context.Context) {
+	return func(ctx context.Context) {
+		if callback != nil {
+			callback(ctx)
+		}
+		r.showPending.Do(func() {
+			go r.ShowPending(ctx)
+		})
+	}
+}
+
+// ShowPending asks the user whether to send the reports of previous crashes using the
+// UploadHandler, or to view the latest report if no UploadHandler is set. The reports
+// are not offered again.
+func (r *Reporter) ShowPending(ctx context.Context) {
+	reports, err := crashreport.Pending(r.options.Dir)
+	if err != nil {
+		r.logger.Error("Unable to read crash reports: %s", err.Error())
+		return
+	}
+	if len(reports) == 0 {
+		return
+	}
+	defer func() {
+		for _, report := range reports {
+			if err := report.MarkShown(); err != nil {
+				r.logger.Error("Unable to update crash report: %s", err.Error())
+			}
+		}
+	}()
+
+	latest := reports[len(reports)-1]
+	message := r.appName + " closed unexpectedly"
+	if len(reports) > 1 {
+		message = fmt.Sprintf("%s closed unexpectedly %d times", r.appName, len(reports))
+	}
+
+	if r.options.UploadHandler != nil {
+		result, err := runtime.MessageDialog(ctx, runtime.MessageDialogOptions{
+			Type:    runtime.QuestionDialog,
+			Title:   "Crash Report",
+			Message: message + ". Would you like to send a crash report?",
+		})
+		if err != nil || result != "Yes" {
+			return
+		}
+		for _, report := range reports {
+			if err := r.options.UploadHandler(*report); err != nil {
+				r.logger.Error("Unable to send crash report %s: %s", report.ID, err.Error())
+			}
+		}
+		return
+	}
+
+	result, err := runtime.MessageDialog(ctx, runtime.MessageDialogOptions{
+		Type:    runtime.QuestionDialog,
+		Title:   "Crash Report",
+		Message: message + ". Would you like to view the crash report?",
+	})
+	if err != nil || result != "Yes" {
+		return
+	}
+	runtime.BrowserOpenURL(ctx, fileURL(latest.Dir))
+}
+
+func goroutines() string {
+	buffer := make([]byte, 64*1024)
+	for {
+		n := goruntime.Stack(buffer, true)
+		if n < len(buffer) || len(buffer) >= 16*1024*1024 {
+			return string(buffer[:n])
+		}
+		buffer = make([]byte, len(buffer)*2)
+	}
+}
+
+func osInfo() crashreport.OSInfo {
+	result := crashreport.OSInfo{
+		Architecture: goruntime.GOARCH,
+		GoVersion:    goruntime.Version(),
+	}
+	info, err := operatingsystem.Info()
+	if err == nil && info != nil {
+		result.ID = info.ID
+		result.Name = info.Name
+		result.Version = info.Version
+	}
+	return result
+}
+
+func fileURL(path string) string {
+	path = filepath.ToSlash(path)
+	if !strings.HasPrefix(path, "/") {
+		// Windows paths, EG: C:/Users
+		path = "/" + path
+	}
+	return (&url.URL{Scheme: "file", Path: path}).String()
+}
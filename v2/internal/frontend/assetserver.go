@@ -0,0 +1,44 @@
+package frontend
+
+import "time"
+
+// Sources an AssetRequest is served from
+const (
+	ServedFromFS        = "fs"        // The Assets fs.FS
+	ServedFromHandler   = "handler"   // The AssetServer Handler
+	ServedFromProxy     = "proxy"     // The frontend DevServer
+	ServedFromRuntime   = "runtime"   // The Wails runtime scripts
+	ServedFromFileRoute = "fileroute" // A FileRoute or signed file URL
+)
+
+// AssetRequest describes a request served by the AssetServer
+type AssetRequest struct {
+	Time       time.Time     `json:"time"`
+	Method     string        `json:"method"`
+	Path       string        `json:"path"`
+	Status     int           `json:"status"`
+	Bytes      int64         `json:"bytes"`
+	Duration   time.Duration `json:"duration"`
+	ServedFrom string        `json:"servedFrom"`
+}
+
+// LatencyBucket counts the requests that took less than UpperBound and more than the
+// UpperBound of the previous bucket. The last bucket has no upper bound and an UpperBound of 0.
+type LatencyBucket struct {
+	UpperBound time.Duration `json:"upperBound"`
+	Count      int           `json:"count"`
+}
+
+// AssetPathStats are the statistics of the requests for a path
+type AssetPathStats struct {
+	Path    string          `json:"path"`
+	Count   int             `json:"count"`
+	Bytes   int64           `json:"bytes"`
+	Latency []LatencyBucket `json:"latency"`
+}
+
+// AssetServerStats are the statistics of the requests served by the AssetServer
+type AssetServerStats struct {
+	Requests []AssetRequest   `json:"requests"` // The most recent requests, oldest first
+	Paths    []AssetPathStats `json:"paths"`    // Sorted by path
+}
@@ -27,6 +27,7 @@ void SetAlwaysOnTop(void* ctx, int onTop);
 void SetMinSize(void* ctx, int width, int height);
 void SetMaxSize(void* ctx, int width, int height);
 void SetPosition(void* ctx, int x, int y);
+void SetAbsolutePosition(void* ctx, int x, int y);
 void Fullscreen(void* ctx);
 void UnFullscreen(void* ctx);
 void Minimise(void* ctx);
@@ -45,6 +46,7 @@ void WindowPrint(void* ctx);
 
 const char* GetSize(void *ctx);
 const char* GetPosition(void *ctx);
+const char* GetAbsolutePosition(void *ctx);
 const bool IsFullScreen(void *ctx);
 const bool IsMinimised(void *ctx);
 const bool IsMaximised(void *ctx);
@@ -114,6 +114,13 @@ void SetPosition(void* inctx, int x, int y) {
     );
 }
 
+void SetAbsolutePosition(void* inctx, int x, int y) {
+    WailsContext *ctx = (__bridge WailsContext*) inctx;
+    ON_MAIN_THREAD(
+       [ctx SetAbsolutePosition:x :y];
+    );
+}
+
 void Center(void* inctx) {
     WailsContext *ctx = (__bridge WailsContext*) inctx;
     ON_MAIN_THREAD(
@@ -182,6 +189,16 @@ const char* GetPosition(void *inctx) {
     return [result UTF8String];
 }
     
+const char* GetAbsolutePosition(void *inctx) {
+    WailsContext *ctx = (__bridge WailsContext*) inctx;
+    NSRect primaryFrame = [[[NSScreen screens] firstObject] frame];
+    NSRect windowFrame = [ctx.mainWindow frame];
+    int x = windowFrame.origin.x - primaryFrame.origin.x;
+    int y = (primaryFrame.origin.y + primaryFrame.size.height) - (windowFrame.origin.y + windowFrame.size.height);
+    NSString *result = [NSString stringWithFormat:@"%d,%d",x,y];
+    return [result UTF8String];
+}
+    
 const bool IsFullScreen(void *inctx) {
     WailsContext *ctx = (__bridge WailsContext*) inctx;
     return [ctx IsFullScreen];
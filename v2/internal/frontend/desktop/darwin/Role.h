@@ -13,5 +13,28 @@ typedef int Role;
 static const Role AppMenu = 1;
 static const Role EditMenu = 2;
 static const Role WindowMenu = 3;
+static const Role FileMenu = 4;
+static const Role ViewMenu = 5;
+static const Role HelpMenu = 6;
+
+static const Role AboutRole = 7;
+static const Role UndoRole = 8;
+static const Role RedoRole = 9;
+static const Role CutRole = 10;
+static const Role CopyRole = 11;
+static const Role PasteRole = 12;
+static const Role PasteAndMatchStyleRole = 13;
+static const Role SelectAllRole = 14;
+static const Role DeleteRole = 15;
+static const Role MinimizeRole = 16;
+static const Role ZoomRole = 17;
+static const Role ToggleFullscreenRole = 18;
+static const Role ReloadRole = 19;
+static const Role CloseWindowRole = 20;
+static const Role QuitRole = 21;
+static const Role HideRole = 22;
+static const Role HideOthersRole = 23;
+static const Role UnhideRole = 24;
+static const Role FrontRole = 25;
 
 #endif /* Role_h */
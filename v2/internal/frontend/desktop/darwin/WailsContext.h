@@ -63,6 +63,7 @@ struct Preferences {
 - (void) CreateWindow:(int)width :(int)height :(bool)frameless :(bool)resizable :(bool)fullscreen :(bool)fullSizeContent :(bool)hideTitleBar :(bool)titlebarAppearsTransparent  :(bool)hideTitle :(bool)useToolbar :(bool)hideToolbarSeparator :(bool)webviewIsTransparent :(bool)hideWindowOnClose :(NSString *)appearance :(bool)windowIsTranslucent :(int)minWidth :(int)minHeight :(int)maxWidth :(int)maxHeight :(bool)fraudulentWebsiteWarningEnabled :(struct Preferences)preferences;
 - (void) SetSize:(int)width :(int)height;
 - (void) SetPosition:(int)x :(int) y;
+- (void) SetAbsolutePosition:(int)x :(int) y;
 - (void) SetMinSize:(int)minWidth :(int)minHeight;
 - (void) SetMaxSize:(int)maxWidth :(int)maxHeight;
 - (void) SetTitle:(NSString*)title;
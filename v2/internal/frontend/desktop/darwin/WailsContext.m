@@ -62,6 +62,16 @@ typedef void (^schemeTaskCaller)(id<WKURLSchemeTask>);
     [self.mainWindow setFrame:windowFrame display:TRUE animate:FALSE];
 }
 
+// The position is relative to the top left of the primary screen, which is the first screen
+- (void) SetAbsolutePosition:(int)x :(int)y {
+    
+    if (self.shuttingDown) return;
+    
+    NSRect primaryFrame = [[[NSScreen screens] firstObject] frame];
+    NSPoint topLeft = { primaryFrame.origin.x + (float)x, primaryFrame.origin.y + primaryFrame.size.height - (float)y };
+    [self.mainWindow setFrameTopLeftPoint:topLeft];
+}
+
 - (void) SetMinSize:(int)minWidth :(int)minHeight {
     
     if (self.shuttingDown) return;
@@ -19,6 +19,7 @@
 - (WailsMenu*) initWithNSTitle :(NSString*)title;
 - (void) appendSubmenu :(WailsMenu*)child;
 - (void) appendRole :(WailsContext*)ctx :(Role)role;
+- (NSMenuItem*) newRoleMenuItem :(WailsContext*)ctx :(Role)role;
 
 - (NSMenuItem*) newMenuItemWithContext :(WailsContext*)ctx :(NSString*)title :(SEL)selector :(NSString*)key :(NSEventModifierFlags)flags;
 - (void*) AppendMenuItem :(WailsContext*)ctx :(NSString*)label :(NSString *)shortcutKey :(int)modifiers :(bool)disabled :(bool)checked :(int)menuItemID;
@@ -121,7 +121,98 @@
             
             break;
         }
+        case FileMenu:
+        {
+            WailsMenu *fileMenu = [[[WailsMenu new] initWithNSTitle:@"File"] autorelease];
+            [fileMenu addItem:[self newRoleMenuItem :ctx :CloseWindowRole]];
+            [self appendSubmenu:fileMenu];
+            break;
+        }
+        case ViewMenu:
+        {
+            WailsMenu *viewMenu = [[[WailsMenu new] initWithNSTitle:@"View"] autorelease];
+            [viewMenu addItem:[self newRoleMenuItem :ctx :ReloadRole]];
+            [viewMenu addItem:[NSMenuItem separatorItem]];
+            [viewMenu addItem:[self newRoleMenuItem :ctx :ToggleFullscreenRole]];
+            [self appendSubmenu:viewMenu];
+            break;
+        }
+        case HelpMenu:
+        {
+            WailsMenu *helpMenu = [[[WailsMenu new] initWithNSTitle:@"Help"] autorelease];
+            NSMenuItem *aboutMenuItem = [self newRoleMenuItem :ctx :AboutRole];
+            if (aboutMenuItem != nil) {
+                [helpMenu addItem:aboutMenuItem];
+            }
+            [self appendSubmenu:helpMenu];
+            break;
+        }
+        default:
+        {
+            NSMenuItem *roleMenuItem = [self newRoleMenuItem :ctx :role];
+            if (roleMenuItem != nil) {
+                [self addItem:roleMenuItem];
+            }
+            break;
+        }
+    }
+}
+
+// newRoleMenuItem returns the menu item of a role that isn't a whole menu, EG: CopyRole
+- (NSMenuItem*) newRoleMenuItem :(WailsContext*)ctx :(Role)role {
+    NSString *appName = [NSRunningApplication currentApplication].localizedName;
+    if( appName == nil ) {
+        appName = [[NSProcessInfo processInfo] processName];
     }
+
+    switch(role) {
+        case AboutRole:
+            if (ctx.aboutTitle == nil) {
+                return nil;
+            }
+            return [self newMenuItemWithContext :ctx :[@"About " stringByAppendingString:appName] :@selector(About) :nil :0];
+        case UndoRole:
+            return [self newMenuItem:@"Undo" :@selector(undo:) :@"z" :NSEventModifierFlagCommand];
+        case RedoRole:
+            return [self newMenuItem:@"Redo" :@selector(redo:) :@"z" :(NSEventModifierFlagShift | NSEventModifierFlagCommand)];
+        case CutRole:
+            return [self newMenuItem:@"Cut" :@selector(cut:) :@"x" :NSEventModifierFlagCommand];
+        case CopyRole:
+            return [self newMenuItem:@"Copy" :@selector(copy:) :@"c" :NSEventModifierFlagCommand];
+        case PasteRole:
+            return [self newMenuItem:@"Paste" :@selector(paste:) :@"v" :NSEventModifierFlagCommand];
+        case PasteAndMatchStyleRole:
+            return [self newMenuItem:@"Paste and Match Style" :@selector(pasteAsRichText:) :@"v" :(NSEventModifierFlagOption | NSEventModifierFlagShift | NSEventModifierFlagCommand)];
+        case SelectAllRole:
+            return [self newMenuItem:@"Select All" :@selector(selectAll:) :@"a" :NSEventModifierFlagCommand];
+        case DeleteRole:
+            return [self newMenuItem:@"Delete" :@selector(delete:) :[self accel:@"backspace"] :0];
+        case MinimizeRole:
+            return [self newMenuItem:@"Minimize" :@selector(performMiniaturize:) :@"m" :NSEventModifierFlagCommand];
+        case ZoomRole:
+            return [self newMenuItem:@"Zoom" :@selector(performZoom:) :@""];
+        case ToggleFullscreenRole:
+            return [self newMenuItem:@"Toggle Full Screen" :@selector(toggleFullScreen:) :@"f" :(NSEventModifierFlagControl | NSEventModifierFlagCommand)];
+        case ReloadRole:
+            return [self newMenuItem:@"Reload" :@selector(reload:) :@"r" :NSEventModifierFlagCommand];
+        case CloseWindowRole:
+            return [self newMenuItem:@"Close Window" :@selector(performClose:) :@"w" :NSEventModifierFlagCommand];
+        case QuitRole:
+        {
+            NSMenuItem* quitMenuItem = [self newMenuItem:[@"Quit " stringByAppendingString:appName] :@selector(Quit) :@"q" :NSEventModifierFlagCommand];
+            quitMenuItem.target = ctx;
+            return quitMenuItem;
+        }
+        case HideRole:
+            return [self newMenuItem:[@"Hide " stringByAppendingString:appName] :@selector(hide:) :@"h" :NSEventModifierFlagCommand];
+        case HideOthersRole:
+            return [self newMenuItem:@"Hide Others" :@selector(hideOtherApplications:) :@"h" :(NSEventModifierFlagOption | NSEventModifierFlagCommand)];
+        case UnhideRole:
+            return [self newMenuItem:@"Show All" :@selector(unhideAllApplications:) :@""];
+        case FrontRole:
+            return [self newMenuItem:@"Bring All to Front" :@selector(arrangeInFront:) :@""];
+    }
+    return nil;
 }
 
 - (void*) AppendMenuItem :(WailsContext*)ctx :(NSString*)label :(NSString *)shortcutKey :(int)modifiers :(bool)disabled :(bool)checked :(int)menuItemID {
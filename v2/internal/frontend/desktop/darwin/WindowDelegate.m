@@ -24,6 +24,48 @@
 
 - (void)windowDidExitFullScreen:(NSNotification *)notification {
     [self.ctx.mainWindow applyWindowConstraints];
+    [self reportWindowState];
+}
+
+- (void)windowDidEnterFullScreen:(NSNotification *)notification {
+    [self reportWindowState];
+}
+
+- (void)windowDidMiniaturize:(NSNotification *)notification {
+    [self reportWindowState];
+}
+
+- (void)windowDidDeminiaturize:(NSNotification *)notification {
+    [self reportWindowState];
+}
+
+- (void)windowDidResize:(NSNotification *)notification {
+    NSRect frame = [self.ctx.mainWindow frame];
+    processWindowResize((int)frame.size.width, (int)frame.size.height);
+    [self reportWindowState];
+}
+
+// The position is relative to the top left of the screen, the same as GetPosition
+- (void)windowDidMove:(NSNotification *)notification {
+    NSScreen* screen = [self.ctx getCurrentScreen];
+    NSRect windowFrame = [self.ctx.mainWindow frame];
+    NSRect screenFrame = [screen visibleFrame];
+    int x = windowFrame.origin.x - screenFrame.origin.x;
+    int y = windowFrame.origin.y - screenFrame.origin.y;
+    y = screenFrame.size.height - y - windowFrame.size.height;
+    processWindowMove(x, y);
+}
+
+- (void)windowDidBecomeKey:(NSNotification *)notification {
+    processWindowFocus(1);
+}
+
+- (void)windowDidResignKey:(NSNotification *)notification {
+    processWindowFocus(0);
+}
+
+- (void)reportWindowState {
+    processWindowState([self.ctx IsMinimised], [self.ctx IsMaximised], [self.ctx IsFullScreen]);
 }
 
 - (void)windowWillEnterFullScreen:(NSNotification *)notification {
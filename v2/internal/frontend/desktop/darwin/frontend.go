@@ -108,6 +108,10 @@ func NewFrontend(ctx context.Context, appoptions *options.App, myLogger *logger.
 	go result.startMessageProcessor()
 	go result.startCallbackProcessor()
 
+	if events, _ := ctx.Value("events").(frontend.Events); events != nil {
+		windowEvents = frontend.NewWindowEvents(events)
+	}
+
 	return result
 }
 
@@ -190,6 +194,14 @@ func (f *Frontend) WindowGetPosition() (int, int) {
 	return f.mainWindow.GetPosition()
 }
 
+func (f *Frontend) WindowSetAbsolutePosition(x, y int) {
+	f.mainWindow.SetAbsolutePosition(x, y)
+}
+
+func (f *Frontend) WindowGetAbsolutePosition() (int, int) {
+	return f.mainWindow.GetAbsolutePosition()
+}
+
 func (f *Frontend) WindowSetSize(width, height int) {
 	f.mainWindow.SetSize(width, height)
 }
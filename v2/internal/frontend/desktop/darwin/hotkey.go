@@ -0,0 +1,22 @@
+//go:build darwin
+// +build darwin
+
+package darwin
+
+import (
+	"fmt"
+
+	"github.com/wailsapp/wails/v2/internal/frontend"
+	"github.com/wailsapp/wails/v2/pkg/menu/keys"
+)
+
+func (f *Frontend) HotkeyRegister(accelerator *keys.Accelerator, _ func()) (int, error) {
+	if accelerator == nil {
+		return 0, fmt.Errorf("no accelerator given for hotkey")
+	}
+	return 0, fmt.Errorf("hotkey '%s' not registered: %w", keys.Stringify(accelerator, "darwin"), frontend.ErrHotkeysNotSupported)
+}
+
+func (f *Frontend) HotkeyUnregister(id int) error {
+	return fmt.Errorf("hotkey %d not unregistered: %w", id, frontend.ErrHotkeysNotSupported)
+}
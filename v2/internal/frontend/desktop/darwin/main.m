@@ -29,6 +29,11 @@ void processCallback(int callbackID) {
     NSLog(@"Process callback %d", callbackID);
 }
 
+void processWindowResize(int width, int height) {}
+void processWindowMove(int x, int y) {}
+void processWindowFocus(int focused) {}
+void processWindowState(int minimised, int maximised, int fullscreen) {}
+
 void processURLRequest(void *ctx, unsigned long long requestId, const char* url, const char *method, const char *headers, const void *body, int bodyLen) {
     NSLog(@"processURLRequest called");
     const char myByteArray[] = { 0x3c,0x68,0x31,0x3e,0x48,0x65,0x6c,0x6c,0x6f,0x20,0x57,0x6f,0x72,0x6c,0x64,0x21,0x3c,0x2f,0x68,0x31,0x3e };
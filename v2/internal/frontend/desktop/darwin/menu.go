@@ -132,3 +132,7 @@ func (f *Frontend) MenuSetApplicationMenu(menu *menu.Menu) {
 func (f *Frontend) MenuUpdateApplicationMenu() {
 	f.mainWindow.UpdateApplicationMenu()
 }
+
+func (f *Frontend) MenuOpenContextMenu(id string, _ string, _ int, _ int) {
+	f.logger.Warning("context menu '%s' not opened: context menus are not yet supported on macOS", id)
+}
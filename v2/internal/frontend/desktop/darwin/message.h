@@ -20,6 +20,10 @@ void processMessageDialogResponse(int);
 void processOpenFileDialogResponse(const char*);
 void processSaveFileDialogResponse(const char*);
 void processCallback(int);
+void processWindowResize(int, int);
+void processWindowMove(int, int);
+void processWindowFocus(int);
+void processWindowState(int, int, int);
 
 #ifdef __cplusplus
 }
@@ -265,6 +265,16 @@ func (w *Window) GetPosition() (int, int) {
 	return parseIntDuo(temp)
 }
 
+func (w *Window) SetAbsolutePosition(x int, y int) {
+	C.SetAbsolutePosition(w.context, C.int(x), C.int(y))
+}
+
+func (w *Window) GetAbsolutePosition() (int, int) {
+	var _result *C.char = C.GetAbsolutePosition(w.context)
+	temp := C.GoString(_result)
+	return parseIntDuo(temp)
+}
+
 func (w *Window) Size() (int, int) {
 	var _result *C.char = C.GetSize(w.context)
 	temp := C.GoString(_result)
@@ -0,0 +1,45 @@
+//go:build darwin
+// +build darwin
+
+package darwin
+
+/*
+#cgo CFLAGS: -x objective-c
+#cgo LDFLAGS: -framework Foundation -framework Cocoa
+#import <Foundation/Foundation.h>
+*/
+import "C"
+import (
+	"github.com/wailsapp/wails/v2/internal/frontend"
+)
+
+// windowEvents emits the resize, move, focus and state events of the main window
+var windowEvents *frontend.WindowEvents
+
+//export processWindowResize
+func processWindowResize(width C.int, height C.int) {
+	if windowEvents != nil {
+		windowEvents.Resized(int(width), int(height))
+	}
+}
+
+//export processWindowMove
+func processWindowMove(x C.int, y C.int) {
+	if windowEvents != nil {
+		windowEvents.Moved(int(x), int(y))
+	}
+}
+
+//export processWindowFocus
+func processWindowFocus(focused C.int) {
+	if windowEvents != nil {
+		windowEvents.FocusChanged(focused != 0)
+	}
+}
+
+//export processWindowState
+func processWindowState(minimised C.int, maximised C.int, fullscreen C.int) {
+	if windowEvents != nil {
+		windowEvents.StateChanged(minimised != 0, maximised != 0, fullscreen != 0)
+	}
+}
@@ -0,0 +1,183 @@
+//go:build linux
+// +build linux
+
+package linux
+
+/*
+#cgo linux pkg-config: gtk+-3.0 webkit2gtk-4.0
+
+#include "gtk/gtk.h"
+#include "window.h"
+
+static GtkMenuItem *toGtkMenuItem(void *pointer) { return (GTK_MENU_ITEM(pointer)); }
+static GtkMenuShell *toGtkMenuShell(void *pointer) { return (GTK_MENU_SHELL(pointer)); }
+static GtkCheckMenuItem *toGtkCheckMenuItem(void *pointer) { return (GTK_CHECK_MENU_ITEM(pointer)); }
+static GtkRadioMenuItem *toGtkRadioMenuItem(void *pointer) { return (GTK_RADIO_MENU_ITEM(pointer)); }
+
+extern void handleContextMenuItemClick(void*);
+
+static void connectContextMenuClick(GtkWidget* menuItem) {
+	g_signal_connect(menuItem, "activate", G_CALLBACK(handleContextMenuItemClick), (void*)menuItem);
+}
+
+// popupContextMenu opens the menu at the given position in the webview. The menu is opened from an idle
+// callback, so the last button press on the webview is passed as the event that triggered it: without
+// it, GTK warns and Wayland compositors can't grab the input for the menu.
+static void popupContextMenu(GtkWidget* menu, void* webview, int x, int y) {
+	GtkWidget *widget = GTK_WIDGET(webview);
+	GdkRectangle rect = {x, y, 1, 1};
+	if (!gtk_widget_get_has_window(widget)) {
+		GtkAllocation allocation;
+		gtk_widget_get_allocation(widget, &allocation);
+		rect.x += allocation.x;
+		rect.y += allocation.y;
+	}
+	gtk_menu_attach_to_widget(GTK_MENU(menu), widget, NULL);
+	gtk_menu_popup_at_rect(GTK_MENU(menu), gtk_widget_get_window(widget), &rect, GDK_GRAVITY_NORTH_WEST, GDK_GRAVITY_NORTH_WEST, LastButtonPress());
+}
+*/
+import "C"
+import (
+	"unsafe"
+
+	"github.com/wailsapp/wails/v2/internal/logger"
+	"github.com/wailsapp/wails/v2/pkg/menu"
+)
+
+// contextMenuManager processes the clicks on the items of the context menus
+type contextMenuManager interface {
+	ContextMenuItemID(contextMenuID string, menuItem *menu.MenuItem) (string, bool)
+	ProcessClick(menuID string, data string, menuType string, parentID string) error
+}
+
+// The open context menu. Only accessed on the main thread.
+var gtkContextMenu *C.GtkWidget
+var gtkContextMenuItems map[*C.GtkWidget]*menu.MenuItem
+var contextMenuID string
+var contextMenuData string
+
+// contextMenus processes the clicks on the context menu items. May be nil.
+var contextMenus contextMenuManager
+var contextMenuLogger *logger.Logger
+
+func (f *Frontend) MenuOpenContextMenu(id string, data string, x int, y int) {
+	for _, contextMenu := range f.frontendOptions.ContextMenus {
+		if contextMenu != nil && contextMenu.ID == id {
+			f.mainWindow.OpenContextMenu(contextMenu.ID, contextMenu.Menu, data, x, y)
+			return
+		}
+	}
+	f.logger.Error("unknown context menu: %s", id)
+}
+
+// OpenContextMenu opens the menu at the given position in the webview. The menu is
+// rebuilt every time it is opened so that it reflects changes to the menu items.
+func (w *Window) OpenContextMenu(id string, inmenu *menu.Menu, data string, x int, y int) {
+	if inmenu == nil {
+		return
+	}
+	invokeOnMainThread(func() {
+		if gtkContextMenu != nil {
+			C.gtk_widget_destroy(gtkContextMenu)
+		}
+		gtkContextMenuItems = make(map[*C.GtkWidget]*menu.MenuItem)
+		contextMenuID = id
+		contextMenuData = data
+
+		gtkContextMenu = C.gtk_menu_new()
+		w.processContextMenu(gtkContextMenu, inmenu)
+		C.popupContextMenu(gtkContextMenu, w.webview, C.int(x), C.int(y))
+	})
+}
+
+func (w *Window) processContextMenu(parent *C.GtkWidget, inmenu *menu.Menu) {
+	var radioGroup *C.GSList
+	for _, menuItem := range inmenu.Items {
+		if menuItem.Hidden {
+			continue
+		}
+		if menuItem.Role != 0 {
+			menuItem = w.roleMenuItem(menuItem)
+			if menuItem == nil {
+				continue
+			}
+		}
+		if menuItem.Type != menu.RadioType {
+			radioGroup = nil
+		}
+
+		var result *C.GtkWidget
+		switch menuItem.Type {
+		case menu.SeparatorType:
+			result = C.gtk_separator_menu_item_new()
+		case menu.TextType:
+			result = GtkMenuItemWithLabel(menuItem.Label)
+		case menu.CheckboxType:
+			result = GtkCheckMenuItemWithLabel(menuItem.Label)
+			if menuItem.Checked {
+				C.gtk_check_menu_item_set_active(C.toGtkCheckMenuItem(unsafe.Pointer(result)), 1)
+			}
+		case menu.RadioType:
+			result = GtkRadioMenuItemWithLabel(menuItem.Label, radioGroup)
+			radioGroup = C.gtk_radio_menu_item_get_group(C.toGtkRadioMenuItem(unsafe.Pointer(result)))
+			if menuItem.Checked {
+				C.gtk_check_menu_item_set_active(C.toGtkCheckMenuItem(unsafe.Pointer(result)), 1)
+			}
+		case menu.SubmenuType:
+			result = GtkMenuItemWithLabel(menuItem.Label)
+			submenu := C.gtk_menu_new()
+			if menuItem.SubMenu != nil {
+				w.processContextMenu(submenu, menuItem.SubMenu)
+			}
+			C.gtk_menu_item_set_submenu(C.toGtkMenuItem(unsafe.Pointer(result)), submenu)
+		default:
+			continue
+		}
+		C.gtk_menu_shell_append(C.toGtkMenuShell(unsafe.Pointer(parent)), result)
+		C.gtk_widget_show(result)
+		if menuItem.Type != menu.SeparatorType {
+			newGtkMenuItem(result).update(menuItem)
+		}
+
+		// Connect after setting the initial state: setting a check menu item active activates it
+		if menuItem.Click != nil || menuItem.Type == menu.CheckboxType || menuItem.Type == menu.RadioType {
+			gtkContextMenuItems[result] = menuItem
+			C.connectContextMenuClick(result)
+		}
+	}
+}
+
+//export handleContextMenuItemClick
+func handleContextMenuItemClick(gtkWidget unsafe.Pointer) {
+	item := gtkContextMenuItems[(*C.GtkWidget)(gtkWidget)]
+	if item == nil || updatingMenu {
+		return
+	}
+	// GTK has already updated the state of check menu items. Deactivating the previous radio item also activates it.
+	checked := (item.Type == menu.CheckboxType || item.Type == menu.RadioType) &&
+		C.gtk_check_menu_item_get_active(C.toGtkCheckMenuItem(gtkWidget)) == 1
+	if item.Type == menu.RadioType && !checked {
+		return
+	}
+
+	// The menu manager updates the state of the items and runs the callback on a new goroutine
+	if contextMenus != nil {
+		if menuID, ok := contextMenus.ContextMenuItemID(contextMenuID, item); ok {
+			err := contextMenus.ProcessClick(menuID, contextMenuData, "ContextMenu", contextMenuID)
+			if err != nil && item.Click != nil {
+				contextMenuLogger.Error("context menu click: %s", err.Error())
+			}
+			return
+		}
+	}
+
+	// Items that are not known to the menu manager, EG: the items of roles
+	if item.Type == menu.CheckboxType || item.Type == menu.RadioType {
+		item.Checked = checked
+	}
+	if item.Click == nil {
+		return
+	}
+	// Run the callback on a new goroutine so it can't block the main thread
+	go item.Click(&menu.CallbackData{MenuItem: item, ContextData: contextMenuData})
+}
@@ -90,8 +90,10 @@ import (
 
 	"github.com/wailsapp/wails/v2/pkg/assetserver"
 	"github.com/wailsapp/wails/v2/pkg/assetserver/webview"
+	"github.com/wailsapp/wails/v2/pkg/crashreport"
 
 	"github.com/wailsapp/wails/v2/internal/binding"
+	"github.com/wailsapp/wails/v2/internal/crashreporter"
 	"github.com/wailsapp/wails/v2/internal/frontend"
 	wailsruntime "github.com/wailsapp/wails/v2/internal/frontend/runtime"
 	"github.com/wailsapp/wails/v2/internal/logger"
@@ -192,6 +194,11 @@ func NewFrontend(ctx context.Context, appoptions *options.App, myLogger *logger.
 	}
 
 	result.mainWindow = NewWindow(appoptions, result.debug, result.devtoolsEnabled)
+	if events, _ := ctx.Value("events").(frontend.Events); events != nil {
+		connectWindowEvents(result.mainWindow, events)
+	}
+	contextMenus, _ = ctx.Value("menumanager").(contextMenuManager)
+	contextMenuLogger = myLogger
 
 	C.install_signal_handlers()
 
@@ -229,14 +236,15 @@ func (f *Frontend) WindowSetDarkTheme() {
 func (f *Frontend) Run(ctx context.Context) error {
 	f.ctx = ctx
 
+	f.mainWindow.Run(f.startURL.String())
+
+	// Start after Run has queued centring the window, so OnStartup can move it
 	go func() {
 		if f.frontendOptions.OnStartup != nil {
 			f.frontendOptions.OnStartup(f.ctx)
 		}
 	}()
 
-	f.mainWindow.Run(f.startURL.String())
-
 	return nil
 }
 
@@ -255,6 +263,14 @@ func (f *Frontend) WindowGetPosition() (int, int) {
 	return f.mainWindow.GetPosition()
 }
 
+func (f *Frontend) WindowSetAbsolutePosition(x, y int) {
+	f.mainWindow.SetAbsolutePosition(x, y)
+}
+
+func (f *Frontend) WindowGetAbsolutePosition() (int, int) {
+	return f.mainWindow.GetAbsolutePosition()
+}
+
 func (f *Frontend) WindowSetSize(width, height int) {
 	f.mainWindow.SetSize(width, height)
 }
@@ -415,6 +431,14 @@ func (f *Frontend) processMessage(message string) {
 		return
 	}
 
+	if strings.HasPrefix(message, "wails:webProcessTerminated:") {
+		reason := "WebKit web process " + strings.TrimPrefix(message, "wails:webProcessTerminated:")
+		f.logger.Error(reason)
+		reporter, _ := f.ctx.Value("crashreporter").(*crashreporter.Reporter)
+		reporter.ReportCrash(crashreport.SourceWebView, reason)
+		return
+	}
+
 	if strings.HasPrefix(message, "resize:") {
 		if !f.mainWindow.IsFullScreen() {
 			sl := strings.Split(message, ":")
@@ -47,6 +47,10 @@ func handleMenuItemClick(gtkWidget unsafe.Pointer) {
 	// main thread will get blocked and so the message loop blocks. As a result the app will block and shows a
 	// "not responding" dialog.
 
+	if updatingMenu {
+		return
+	}
+
 	item := gtkSignalToMenuItem[(*C.GtkWidget)(gtkWidget)]
 	switch item.Type {
 	case menu.CheckboxType:
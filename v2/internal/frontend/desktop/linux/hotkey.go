@@ -0,0 +1,233 @@
+//go:build linux
+// +build linux
+
+package linux
+
+/*
+#cgo linux pkg-config: gtk+-3.0 x11
+
+#include "gtk/gtk.h"
+#include "gdk/gdkx.h"
+#include <X11/Xlib.h>
+
+extern gboolean handleHotkey(guint keycode, guint modifiers);
+
+// The lock and numlock modifiers are ignored when matching hotkeys
+static const guint ignoredModifiers[] = { 0, LockMask, Mod2Mask, LockMask | Mod2Mask };
+
+static gboolean isX11Display() {
+	return GDK_IS_X11_DISPLAY(gdk_display_get_default());
+}
+
+static GdkFilterReturn hotkeyFilter(GdkXEvent *gdkxevent, GdkEvent *event, gpointer data) {
+	XEvent *xevent = (XEvent *)gdkxevent;
+	if (xevent->type != KeyPress) {
+		return GDK_FILTER_CONTINUE;
+	}
+	guint modifiers = xevent->xkey.state & ~(LockMask | Mod2Mask);
+	if (handleHotkey(xevent->xkey.keycode, modifiers)) {
+		return GDK_FILTER_REMOVE;
+	}
+	return GDK_FILTER_CONTINUE;
+}
+
+static void installHotkeyFilter() {
+	gdk_window_add_filter(gdk_get_default_root_window(), hotkeyFilter, NULL);
+}
+
+// grabHotkey grabs the key on the root window. It returns -1 if the key is not on the keyboard
+// or the X error code if the key could not be grabbed, EG: BadAccess if another client grabbed it.
+static int grabHotkey(guint keyval, guint modifiers, guint *keycode) {
+	GdkDisplay *display = gdk_display_get_default();
+	Display *xdisplay = GDK_DISPLAY_XDISPLAY(display);
+
+	*keycode = XKeysymToKeycode(xdisplay, keyval);
+	if (*keycode == 0) {
+		return -1;
+	}
+
+	Window root = GDK_WINDOW_XID(gdk_get_default_root_window());
+	gdk_x11_display_error_trap_push(display);
+	for (int i = 0; i < G_N_ELEMENTS(ignoredModifiers); i++) {
+		XGrabKey(xdisplay, *keycode, modifiers | ignoredModifiers[i], root, False, GrabModeAsync, GrabModeAsync);
+	}
+	int error = gdk_x11_display_error_trap_pop(display);
+	if (error != Success) {
+		// Release the variants that were grabbed
+		gdk_x11_display_error_trap_push(display);
+		for (int i = 0; i < G_N_ELEMENTS(ignoredModifiers); i++) {
+			XUngrabKey(xdisplay, *keycode, modifiers | ignoredModifiers[i], root);
+		}
+		gdk_x11_display_error_trap_pop_ignored(display);
+	}
+	return error;
+}
+
+static void ungrabHotkey(guint keycode, guint modifiers) {
+	GdkDisplay *display = gdk_display_get_default();
+	Display *xdisplay = GDK_DISPLAY_XDISPLAY(display);
+	Window root = GDK_WINDOW_XID(gdk_get_default_root_window());
+
+	gdk_x11_display_error_trap_push(display);
+	for (int i = 0; i < G_N_ELEMENTS(ignoredModifiers); i++) {
+		XUngrabKey(xdisplay, keycode, modifiers | ignoredModifiers[i], root);
+	}
+	gdk_x11_display_error_trap_pop_ignored(display);
+}
+*/
+import "C"
+import (
+	"fmt"
+	"sync"
+
+	"github.com/wailsapp/wails/v2/internal/frontend"
+	"github.com/wailsapp/wails/v2/pkg/menu/keys"
+)
+
+// hotkey is a registered global hotkey. On X11 the key is grabbed on the root window,
+// on Wayland it is bound through the GlobalShortcuts portal.
+type hotkey struct {
+	id          int
+	accelerator *keys.Accelerator
+	callback    func()
+	keyval      C.guint
+	modifiers   C.guint
+	keycode     C.guint
+}
+
+var (
+	// hotkeyRegisterLock serialises registering and unregistering hotkeys
+	hotkeyRegisterLock sync.Mutex
+	// hotkeyLock guards the hotkeys map
+	hotkeyLock          sync.Mutex
+	hotkeys             = map[int]*hotkey{}
+	hotkeyIdCounter     int
+	hotkeyFilterEnabled bool
+)
+
+func (f *Frontend) HotkeyRegister(accelerator *keys.Accelerator, callback func()) (int, error) {
+	if accelerator == nil {
+		return 0, fmt.Errorf("no accelerator given for hotkey")
+	}
+	name := keys.Stringify(accelerator, "linux")
+	if callback == nil {
+		return 0, fmt.Errorf("no callback given for hotkey '%s'", name)
+	}
+
+	keyval := hotkeyKeyval(accelerator.Key)
+	if keyval == 0 {
+		return 0, fmt.Errorf("hotkey '%s' not registered: unknown key '%s'", name, accelerator.Key)
+	}
+
+	hotkeyRegisterLock.Lock()
+	defer hotkeyRegisterLock.Unlock()
+
+	result := &hotkey{
+		accelerator: accelerator,
+		callback:    callback,
+		keyval:      keyval,
+		modifiers:   C.guint(parseModifiers(accelerator.Modifiers)),
+	}
+
+	hotkeyLock.Lock()
+	for _, existing := range hotkeys {
+		if existing.keyval == result.keyval && existing.modifiers == result.modifiers {
+			hotkeyLock.Unlock()
+			return 0, fmt.Errorf("hotkey '%s' not registered: %w", name, frontend.ErrHotkeyInUse)
+		}
+	}
+	hotkeyIdCounter++
+	result.id = hotkeyIdCounter
+	hotkeyLock.Unlock()
+
+	var x11 bool
+	var err error
+	var wg sync.WaitGroup
+	wg.Add(1)
+	invokeOnMainThread(func() {
+		defer wg.Done()
+		x11 = C.isX11Display() != 0
+		if x11 {
+			err = grabHotkey(result, name)
+		}
+	})
+	wg.Wait()
+
+	if !x11 {
+		err = hotkeyPortal.bind(result, name)
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	hotkeyLock.Lock()
+	hotkeys[result.id] = result
+	hotkeyLock.Unlock()
+
+	return result.id, nil
+}
+
+func (f *Frontend) HotkeyUnregister(id int) error {
+	hotkeyRegisterLock.Lock()
+	defer hotkeyRegisterLock.Unlock()
+
+	hotkeyLock.Lock()
+	result := hotkeys[id]
+	delete(hotkeys, id)
+	hotkeyLock.Unlock()
+
+	if result == nil {
+		return fmt.Errorf("hotkey %d is not registered", id)
+	}
+
+	if result.keycode != 0 {
+		invokeOnMainThread(func() {
+			C.ungrabHotkey(result.keycode, result.modifiers)
+		})
+		return nil
+	}
+
+	return hotkeyPortal.unbind(result)
+}
+
+// grabHotkey grabs the hotkey on X11. Must be called on the main thread.
+func grabHotkey(result *hotkey, name string) error {
+	if !hotkeyFilterEnabled {
+		C.installHotkeyFilter()
+		hotkeyFilterEnabled = true
+	}
+	var keycode C.guint
+	switch C.grabHotkey(result.keyval, result.modifiers, &keycode) {
+	case C.Success:
+		result.keycode = keycode
+		return nil
+	case -1:
+		return fmt.Errorf("hotkey '%s' not registered: key '%s' is not on the keyboard", name, result.accelerator.Key)
+	case C.BadAccess:
+		return fmt.Errorf("hotkey '%s' not registered: %w", name, frontend.ErrHotkeyInUse)
+	default:
+		return fmt.Errorf("hotkey '%s' not registered: unable to grab key", name)
+	}
+}
+
+// hotkeyKeyval returns the keyval of the key. Unlike menu accelerators, space is the main
+// keyboard key and not the keypad one.
+func hotkeyKeyval(key string) C.guint {
+	if key == "space" {
+		return C.guint(' ')
+	}
+	return parseKey(key)
+}
+
+//export handleHotkey
+func handleHotkey(keycode C.guint, modifiers C.guint) C.gboolean {
+	hotkeyLock.Lock()
+	defer hotkeyLock.Unlock()
+	for _, registered := range hotkeys {
+		if registered.keycode == keycode && registered.modifiers == modifiers {
+			go registered.callback()
+			return C.gboolean(1)
+		}
+	}
+	return C.gboolean(0)
+}
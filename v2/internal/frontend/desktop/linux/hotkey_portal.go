@@ -0,0 +1,364 @@
+//go:build linux
+// +build linux
+
+package linux
+
+/*
+#cgo linux pkg-config: gtk+-3.0
+
+#include "gtk/gtk.h"
+#include <gio/gio.h>
+
+extern void handlePortalResponse(char *path, guint response, GVariant *results);
+extern void handlePortalActivated(char *shortcutID);
+
+static void onPortalResponse(GDBusConnection *connection, const gchar *sender, const gchar *path,
+		const gchar *interface, const gchar *signal, GVariant *parameters, gpointer data) {
+	guint32 response;
+	GVariant *results;
+	g_variant_get(parameters, "(u@a{sv})", &response, &results);
+	handlePortalResponse((char *)path, response, results);
+	g_variant_unref(results);
+}
+
+static void onPortalActivated(GDBusConnection *connection, const gchar *sender, const gchar *path,
+		const gchar *interface, const gchar *signal, GVariant *parameters, gpointer data) {
+	const gchar *shortcutID;
+	g_variant_get_child(parameters, 1, "&s", &shortcutID);
+	handlePortalActivated((char *)shortcutID);
+}
+
+// portalConnect connects to the session bus and subscribes to the portal signals
+static GDBusConnection *portalConnect(char **error) {
+	GError *err = NULL;
+	GDBusConnection *connection = g_bus_get_sync(G_BUS_TYPE_SESSION, NULL, &err);
+	if (connection == NULL) {
+		*error = g_strdup(err->message);
+		g_error_free(err);
+		return NULL;
+	}
+	g_dbus_connection_signal_subscribe(connection, "org.freedesktop.portal.Desktop", "org.freedesktop.portal.Request",
+		"Response", NULL, NULL, G_DBUS_SIGNAL_FLAGS_NONE, onPortalResponse, NULL, NULL);
+	g_dbus_connection_signal_subscribe(connection, "org.freedesktop.portal.Desktop", "org.freedesktop.portal.GlobalShortcuts",
+		"Activated", NULL, NULL, G_DBUS_SIGNAL_FLAGS_NONE, onPortalActivated, NULL, NULL);
+	return connection;
+}
+
+// portalCall calls a GlobalShortcuts method and returns the handle of the request
+static char *portalCall(GDBusConnection *connection, const char *method, GVariant *parameters, char **error) {
+	GError *err = NULL;
+	GVariant *result = g_dbus_connection_call_sync(connection, "org.freedesktop.portal.Desktop", "/org/freedesktop/portal/desktop",
+		"org.freedesktop.portal.GlobalShortcuts", method, parameters, G_VARIANT_TYPE("(o)"), G_DBUS_CALL_FLAGS_NONE, -1, NULL, &err);
+	if (result == NULL) {
+		*error = g_strdup(err->message);
+		g_error_free(err);
+		return NULL;
+	}
+	char *handle;
+	g_variant_get(result, "(o)", &handle);
+	g_variant_unref(result);
+	return handle;
+}
+
+static char *portalCreateSession(GDBusConnection *connection, char *token, char **error) {
+	GVariantBuilder options;
+	g_variant_builder_init(&options, G_VARIANT_TYPE_VARDICT);
+	g_variant_builder_add(&options, "{sv}", "handle_token", g_variant_new_string(token));
+	g_variant_builder_add(&options, "{sv}", "session_handle_token", g_variant_new_string(token));
+	return portalCall(connection, "CreateSession", g_variant_new("(a{sv})", &options), error);
+}
+
+static GVariantBuilder *newShortcuts() {
+	return g_variant_builder_new(G_VARIANT_TYPE("a(sa{sv})"));
+}
+
+static void addShortcut(GVariantBuilder *shortcuts, char *id, char *description, char *trigger) {
+	GVariantBuilder options;
+	g_variant_builder_init(&options, G_VARIANT_TYPE_VARDICT);
+	g_variant_builder_add(&options, "{sv}", "description", g_variant_new_string(description));
+	g_variant_builder_add(&options, "{sv}", "preferred_trigger", g_variant_new_string(trigger));
+	g_variant_builder_add(shortcuts, "(sa{sv})", id, &options);
+}
+
+static char *portalBindShortcuts(GDBusConnection *connection, char *session, GVariantBuilder *shortcuts, char *token, char **error) {
+	GVariantBuilder options;
+	g_variant_builder_init(&options, G_VARIANT_TYPE_VARDICT);
+	g_variant_builder_add(&options, "{sv}", "handle_token", g_variant_new_string(token));
+	GVariant *parameters = g_variant_new("(oa(sa{sv})sa{sv})", session, shortcuts, "", &options);
+	g_variant_builder_unref(shortcuts);
+	return portalCall(connection, "BindShortcuts", parameters, error);
+}
+
+// lookupString returns a copy of the string or object path with the given key in the results
+static char *lookupString(GVariant *results, char *key) {
+	GVariant *value = g_variant_lookup_value(results, key, NULL);
+	if (value == NULL) {
+		return NULL;
+	}
+	char *result = NULL;
+	if (g_variant_is_of_type(value, G_VARIANT_TYPE_STRING) || g_variant_is_of_type(value, G_VARIANT_TYPE_OBJECT_PATH)) {
+		result = g_variant_dup_string(value, NULL);
+	}
+	g_variant_unref(value);
+	return result;
+}
+
+// isShortcutBound returns true if the shortcut with the given id is in the results of BindShortcuts
+static gboolean isShortcutBound(GVariant *results, char *id) {
+	GVariant *shortcuts = g_variant_lookup_value(results, "shortcuts", G_VARIANT_TYPE("a(sa{sv})"));
+	if (shortcuts == NULL) {
+		return FALSE;
+	}
+	gboolean found = FALSE;
+	GVariantIter iter;
+	const gchar *shortcutID;
+	GVariant *options;
+	g_variant_iter_init(&iter, shortcuts);
+	while (g_variant_iter_next(&iter, "(&s@a{sv})", &shortcutID, &options)) {
+		if (g_strcmp0(shortcutID, id) == 0) {
+			found = TRUE;
+		}
+		g_variant_unref(options);
+	}
+	g_variant_unref(shortcuts);
+	return found;
+}
+*/
+import "C"
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unsafe"
+
+	"github.com/wailsapp/wails/v2/internal/frontend"
+	"github.com/wailsapp/wails/v2/pkg/menu/keys"
+)
+
+// portalRequestTimeout is how long to wait for the response to a portal request. It allows
+// for the user to approve the shortcuts.
+const portalRequestTimeout = 2 * time.Minute
+
+// portal binds hotkeys through the XDG GlobalShortcuts portal, which is used on Wayland
+// as clients can't grab keys there. The user may be asked to approve the shortcuts.
+// The connection, session and requests are only accessed on the main thread.
+type portal struct {
+	connection   *C.GDBusConnection
+	session      string
+	tokenCounter int
+	requests     map[string]func(response C.guint, results *C.GVariant)
+
+	// bound are the hotkeys bound to the session, guarded by hotkeyRegisterLock
+	bound map[int]*hotkey
+}
+
+var hotkeyPortal = &portal{
+	requests: make(map[string]func(response C.guint, results *C.GVariant)),
+	bound:    make(map[int]*hotkey),
+}
+
+// boundShortcuts returns a copy of the hotkeys bound to the session
+func (p *portal) boundShortcuts() map[int]*hotkey {
+	result := make(map[int]*hotkey, len(p.bound))
+	for id, bound := range p.bound {
+		result[id] = bound
+	}
+	return result
+}
+
+// bind binds the hotkey along with the hotkeys that are already bound
+func (p *portal) bind(result *hotkey, name string) error {
+	err := p.createSession()
+	if err != nil {
+		return fmt.Errorf("hotkey '%s' not registered: %w", name, err)
+	}
+	shortcuts := p.boundShortcuts()
+	shortcuts[result.id] = result
+	bound, err := p.bindShortcuts(shortcuts)
+	if err != nil {
+		return fmt.Errorf("hotkey '%s' not registered: %w", name, err)
+	}
+	p.bound = bound
+	if p.bound[result.id] == nil {
+		// The desktop did not assign a trigger, EG: because it is in use or the user removed it
+		return fmt.Errorf("hotkey '%s' not registered: no trigger assigned by the desktop: %w", name, frontend.ErrHotkeyInUse)
+	}
+	return nil
+}
+
+// unbind binds the remaining hotkeys, which removes the hotkey from the session
+func (p *portal) unbind(result *hotkey) error {
+	if p.bound[result.id] == nil {
+		return nil
+	}
+	shortcuts := p.boundShortcuts()
+	delete(shortcuts, result.id)
+	bound, err := p.bindShortcuts(shortcuts)
+	if err != nil {
+		return fmt.Errorf("hotkey '%s' not unregistered: %w", keys.Stringify(result.accelerator, "linux"), err)
+	}
+	p.bound = bound
+	return nil
+}
+
+func (p *portal) createSession() error {
+	if p.session != "" {
+		return nil
+	}
+	err := p.request(func(token *C.char, cerr **C.char) *C.char {
+		return C.portalCreateSession(p.connection, token, cerr)
+	}, func(results *C.GVariant) {
+		key := C.CString("session_handle")
+		defer C.g_free(C.gpointer(key))
+		session := C.lookupString(results, key)
+		if session != nil {
+			p.session = C.GoString(session)
+			C.g_free(C.gpointer(session))
+		}
+	})
+	if err != nil {
+		return err
+	}
+	if p.session == "" {
+		return errors.New("global shortcuts portal did not create a session")
+	}
+	return nil
+}
+
+// bindShortcuts binds the hotkeys to the session and returns the hotkeys the portal bound
+func (p *portal) bindShortcuts(shortcuts map[int]*hotkey) (map[int]*hotkey, error) {
+	bound := make(map[int]*hotkey)
+	err := p.request(func(token *C.char, cerr **C.char) *C.char {
+		builder := C.newShortcuts()
+		for _, shortcut := range shortcuts {
+			id := C.CString(portalShortcutID(shortcut.id))
+			description := C.CString(keys.Stringify(shortcut.accelerator, "linux"))
+			trigger := C.CString(portalTrigger(shortcut))
+			C.addShortcut(builder, id, description, trigger)
+			C.g_free(C.gpointer(id))
+			C.g_free(C.gpointer(description))
+			C.g_free(C.gpointer(trigger))
+		}
+		session := C.CString(p.session)
+		defer C.g_free(C.gpointer(session))
+		return C.portalBindShortcuts(p.connection, session, builder, token, cerr)
+	}, func(results *C.GVariant) {
+		for _, shortcut := range shortcuts {
+			id := C.CString(portalShortcutID(shortcut.id))
+			if C.isShortcutBound(results, id) != 0 {
+				bound[shortcut.id] = shortcut
+			}
+			C.g_free(C.gpointer(id))
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	return bound, nil
+}
+
+// request calls a portal method on the main thread and waits for the response to the request.
+// The handler processes the results on the main thread if the request succeeded.
+// The response is delivered on the main thread, so request can't be called from it.
+func (p *portal) request(call func(token *C.char, cerr **C.char) *C.char, handler func(results *C.GVariant)) error {
+	if isMainThread() {
+		return errors.New("global shortcuts portal: hotkeys can't be registered on the main thread")
+	}
+	done := make(chan error, 1)
+	var path string
+	invokeOnMainThread(func() {
+		if p.connection == nil {
+			var cerr *C.char
+			p.connection = C.portalConnect(&cerr)
+			if p.connection == nil {
+				done <- fmt.Errorf("unable to connect to the session bus: %s", portalError(cerr))
+				return
+			}
+		}
+
+		p.tokenCounter++
+		token := C.CString(fmt.Sprintf("wails_hotkey_%d", p.tokenCounter))
+		defer C.g_free(C.gpointer(token))
+
+		var cerr *C.char
+		handle := call(token, &cerr)
+		if handle == nil {
+			done <- fmt.Errorf("global shortcuts portal: %s", portalError(cerr))
+			return
+		}
+		path = C.GoString(handle)
+		C.g_free(C.gpointer(handle))
+
+		p.requests[path] = func(response C.guint, results *C.GVariant) {
+			switch response {
+			case 0:
+				handler(results)
+				done <- nil
+			case 1:
+				done <- errors.New("global shortcuts portal: cancelled by the user")
+			default:
+				done <- errors.New("global shortcuts portal: request failed")
+			}
+		}
+	})
+	select {
+	case err := <-done:
+		return err
+	case <-time.After(portalRequestTimeout):
+		// Ignore the response if it arrives later
+		invokeOnMainThread(func() {
+			delete(p.requests, path)
+		})
+		return errors.New("global shortcuts portal: timed out waiting for a response")
+	}
+}
+
+func portalShortcutID(id int) string {
+	return fmt.Sprintf("hotkey-%d", id)
+}
+
+// portalTrigger returns the trigger of the hotkey in the format of the XDG shortcuts specification
+func portalTrigger(shortcut *hotkey) string {
+	var result []string
+	if shortcut.modifiers&C.GDK_CONTROL_MASK != 0 {
+		result = append(result, "CTRL")
+	}
+	if shortcut.modifiers&C.GDK_MOD1_MASK != 0 {
+		result = append(result, "ALT")
+	}
+	if shortcut.modifiers&C.GDK_SHIFT_MASK != 0 {
+		result = append(result, "SHIFT")
+	}
+	result = append(result, C.GoString((*C.char)(unsafe.Pointer(C.gdk_keyval_name(shortcut.keyval)))))
+	return strings.Join(result, "+")
+}
+
+func portalError(cerr *C.char) string {
+	defer C.g_free(C.gpointer(cerr))
+	return C.GoString(cerr)
+}
+
+//export handlePortalResponse
+func handlePortalResponse(path *C.char, response C.guint, results *C.GVariant) {
+	handler := hotkeyPortal.requests[C.GoString(path)]
+	if handler == nil {
+		return
+	}
+	delete(hotkeyPortal.requests, C.GoString(path))
+	handler(response, results)
+}
+
+//export handlePortalActivated
+func handlePortalActivated(shortcutID *C.char) {
+	var id int
+	_, err := fmt.Sscanf(C.GoString(shortcutID), "hotkey-%d", &id)
+	if err != nil {
+		return
+	}
+	hotkeyLock.Lock()
+	defer hotkeyLock.Unlock()
+	if registered := hotkeys[id]; registered != nil {
+		go registered.callback()
+	}
+}
@@ -57,6 +57,18 @@ func tryInvokeOnCurrentGoRoutine(f func()) bool {
 	return true
 }
 
+// isMainThread returns true if the current goroutine runs on the main thread
+func isMainThread() bool {
+	m.Lock()
+	mainThreadID := mainTid
+	m.Unlock()
+
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
+	return mainThreadID == unix.Gettid()
+}
+
 //export invokeCallbacks
 func invokeCallbacks(_ unsafe.Pointer) C.gboolean {
 	runtime.LockOSThread()
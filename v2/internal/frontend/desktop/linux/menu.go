@@ -30,10 +30,23 @@ gulong connectClick(GtkWidget* menuItem) {
 void addAccelerator(GtkWidget* menuItem, GtkAccelGroup* group, guint key, GdkModifierType mods) {
 	gtk_widget_add_accelerator(menuItem, "activate", group, key, mods, GTK_ACCEL_VISIBLE);
 }
+
+// replaceMenubar replaces the menubar at the top of the window
+void replaceMenubar(GtkWidget* vbox, GtkWidget* oldMenubar, GtkWidget* newMenubar) {
+	if (oldMenubar != NULL) {
+		gtk_widget_destroy(oldMenubar);
+	}
+	gtk_box_pack_start(GTK_BOX(vbox), newMenubar, FALSE, FALSE, 0);
+	gtk_box_reorder_child(GTK_BOX(vbox), newMenubar, 0);
+	gtk_widget_show_all(newMenubar);
+}
 */
 import "C"
-import "github.com/wailsapp/wails/v2/pkg/menu"
-import "unsafe"
+import (
+	"unsafe"
+
+	"github.com/wailsapp/wails/v2/pkg/menu"
+)
 
 var menuIdCounter int
 var menuItemToId map[*menu.MenuItem]int
@@ -43,21 +56,76 @@ var gtkMenuCache map[*menu.MenuItem]*C.GtkWidget
 var gtkRadioMenuCache map[*menu.MenuItem][]*C.GtkWidget
 var gtkSignalHandlers map[*C.GtkWidget]C.gulong
 var gtkSignalToMenuItem map[*C.GtkWidget]*menu.MenuItem
+var gtkMenuItemCache map[*menu.MenuItem][]*gtkMenuItem
+
+// menuStructureItem identifies a menu item that has a widget in the menubar
+type menuStructureItem struct {
+	menuItem *menu.MenuItem
+	menuType menu.Type
+}
 
 func (f *Frontend) MenuSetApplicationMenu(menu *menu.Menu) {
-	f.mainWindow.SetApplicationMenu(menu)
+	invokeOnMainThread(func() { f.mainWindow.SetApplicationMenu(menu) })
 }
 
 func (f *Frontend) MenuUpdateApplicationMenu() {
-	f.mainWindow.SetApplicationMenu(f.mainWindow.applicationMenu)
+	invokeOnMainThread(f.mainWindow.UpdateApplicationMenu)
+}
+
+// UpdateApplicationMenu updates the labels, icons and state of the menubar items.
+// The menubar is only rebuilt if items have been added, removed, hidden or shown.
+func (w *Window) UpdateApplicationMenu() {
+	if w.applicationMenu == nil {
+		return
+	}
+	if !sameMenuStructure(menuStructure(w.applicationMenu, nil), w.menuStructure) {
+		w.SetApplicationMenu(w.applicationMenu)
+		return
+	}
+	for menuItem, gtkMenuItems := range gtkMenuItemCache {
+		for _, gtkMenuItem := range gtkMenuItems {
+			gtkMenuItem.update(menuItem)
+		}
+	}
+}
+
+// menuStructure returns the items of the menu that have a widget in the menubar
+func menuStructure(inmenu *menu.Menu, result []menuStructureItem) []menuStructureItem {
+	for _, menuItem := range inmenu.Items {
+		if menuItem.Hidden {
+			continue
+		}
+		result = append(result, menuStructureItem{menuItem: menuItem, menuType: menuItem.Type})
+		if menuItem.Role == 0 && menuItem.SubMenu != nil {
+			result = menuStructure(menuItem.SubMenu, result)
+		}
+	}
+	return result
+}
+
+func sameMenuStructure(a []menuStructureItem, b []menuStructureItem) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
 }
 
 func (w *Window) SetApplicationMenu(inmenu *menu.Menu) {
 	if inmenu == nil {
 		return
 	}
+	w.applicationMenu = inmenu
+	w.menuStructure = menuStructure(inmenu, nil)
 
 	// Setup accelerator group
+	if w.accels != nil {
+		C.gtk_window_remove_accel_group(w.asGTKWindow(), w.accels)
+	}
 	w.accels = C.gtk_accel_group_new()
 	C.gtk_window_add_accel_group(w.asGTKWindow(), w.accels)
 
@@ -68,23 +136,47 @@ func (w *Window) SetApplicationMenu(inmenu *menu.Menu) {
 	gtkRadioMenuCache = make(map[*menu.MenuItem][]*C.GtkWidget)
 	gtkSignalHandlers = make(map[*C.GtkWidget]C.gulong)
 	gtkSignalToMenuItem = make(map[*C.GtkWidget]*menu.MenuItem)
+	gtkMenuItemCache = make(map[*menu.MenuItem][]*gtkMenuItem)
 
 	// Increase ref count?
+	oldMenubar := w.menubar
 	w.menubar = C.gtk_menu_bar_new()
 
 	processMenu(w, inmenu)
 
 	C.gtk_widget_show(w.menubar)
+
+	// Once the window is running, the menubar needs to be replaced
+	if C.gtk_widget_get_parent(w.webviewBox) != nil {
+		C.replaceMenubar(w.vbox, oldMenubar, w.menubar)
+	}
+}
+
+// addGtkMenuItem sets the label, icon and state of the widget and caches it for updates
+func addGtkMenuItem(menuItem *menu.MenuItem, widget *C.GtkWidget) {
+	result := newGtkMenuItem(widget)
+	result.update(menuItem)
+	gtkMenuItemCache[menuItem] = append(gtkMenuItemCache[menuItem], result)
 }
 
 func processMenu(window *Window, menu *menu.Menu) {
 	for _, menuItem := range menu.Items {
-		submenu := processSubmenu(menuItem, window.accels)
+		if menuItem.Hidden {
+			continue
+		}
+		if menuItem.Role != 0 {
+			menuItem = window.roleMenuItem(menuItem)
+		}
+		if menuItem == nil || menuItem.SubMenu == nil {
+			continue
+		}
+		submenu := processSubmenu(window, menuItem, window.accels)
 		C.gtk_menu_shell_append(C.toGtkMenuShell(unsafe.Pointer(window.menubar)), submenu)
+		addGtkMenuItem(menuItem, submenu)
 	}
 }
 
-func processSubmenu(menuItem *menu.MenuItem, group *C.GtkAccelGroup) *C.GtkWidget {
+func processSubmenu(window *Window, menuItem *menu.MenuItem, group *C.GtkAccelGroup) *C.GtkWidget {
 	existingMenu := gtkMenuCache[menuItem]
 	if existingMenu != nil {
 		return existingMenu
@@ -96,7 +188,7 @@ func processSubmenu(menuItem *menu.MenuItem, group *C.GtkAccelGroup) *C.GtkWidge
 		menuIdToItem[menuID] = menuItem
 		menuItemToId[menuItem] = menuID
 		menuIdCounter++
-		processMenuItem(gtkMenu, menuItem, group)
+		processMenuItem(window, gtkMenu, menuItem, group)
 	}
 	C.gtk_menu_item_set_submenu(C.toGtkMenuItem(unsafe.Pointer(submenu)), gtkMenu)
 	gtkMenuCache[menuItem] = existingMenu
@@ -105,11 +197,18 @@ func processSubmenu(menuItem *menu.MenuItem, group *C.GtkAccelGroup) *C.GtkWidge
 
 var currentRadioGroup *C.GSList
 
-func processMenuItem(parent *C.GtkWidget, menuItem *menu.MenuItem, group *C.GtkAccelGroup) {
+func processMenuItem(window *Window, parent *C.GtkWidget, menuItem *menu.MenuItem, group *C.GtkAccelGroup) {
 	if menuItem.Hidden {
 		return
 	}
 
+	if menuItem.Role != 0 {
+		menuItem = window.roleMenuItem(menuItem)
+		if menuItem == nil {
+			return
+		}
+	}
+
 	if menuItem.Type != menu.RadioType {
 		currentRadioGroup = nil
 	}
@@ -140,7 +239,7 @@ func processMenuItem(parent *C.GtkWidget, menuItem *menu.MenuItem, group *C.GtkA
 		}
 		gtkRadioMenuCache[menuItem] = append(gtkRadioMenuCache[menuItem], result)
 	case menu.SubmenuType:
-		result = processSubmenu(menuItem, group)
+		result = processSubmenu(window, menuItem, group)
 	}
 	C.gtk_menu_shell_append(C.toGtkMenuShell(unsafe.Pointer(parent)), result)
 	C.gtk_widget_show(result)
@@ -151,9 +250,7 @@ func processMenuItem(parent *C.GtkWidget, menuItem *menu.MenuItem, group *C.GtkA
 		gtkSignalToMenuItem[result] = menuItem
 	}
 
-	if menuItem.Disabled {
-		C.gtk_widget_set_sensitive(result, 0)
-	}
+	addGtkMenuItem(menuItem, result)
 
 	if menuItem.Accelerator != nil {
 		key, mods := acceleratorToGTK(menuItem.Accelerator)
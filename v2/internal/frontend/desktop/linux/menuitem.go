@@ -0,0 +1,141 @@
+//go:build linux
+// +build linux
+
+package linux
+
+/*
+#cgo linux pkg-config: gtk+-3.0 webkit2gtk-4.0
+
+#include <stdlib.h>
+#include <string.h>
+#include "gtk/gtk.h"
+
+static GtkCheckMenuItem *toGtkCheckMenuItem(void *pointer) { return (GTK_CHECK_MENU_ITEM(pointer)); }
+static GtkImage *toGtkImage(void *pointer) { return (GTK_IMAGE(pointer)); }
+
+static GtkWidget* menuItemLabel(GtkWidget *menuItem) {
+	return gtk_bin_get_child(GTK_BIN(menuItem));
+}
+
+// addMenuItemImage puts an image before the label of the menu item and returns the image
+static GtkWidget* addMenuItemImage(GtkWidget *menuItem, GtkWidget *label) {
+	GtkWidget *box = gtk_box_new(GTK_ORIENTATION_HORIZONTAL, 6);
+	GtkWidget *image = gtk_image_new();
+
+	g_object_ref(label);
+	gtk_container_remove(GTK_CONTAINER(menuItem), label);
+	gtk_box_pack_start(GTK_BOX(box), image, FALSE, FALSE, 0);
+	gtk_box_pack_start(GTK_BOX(box), label, TRUE, TRUE, 0);
+	g_object_unref(label);
+
+	gtk_container_add(GTK_CONTAINER(menuItem), box);
+	gtk_widget_show_all(box);
+	return image;
+}
+
+static void setMenuItemLabel(GtkWidget *label, const char *text, const char *sublabel) {
+	if (strlen(sublabel) == 0) {
+		gtk_label_set_text(GTK_LABEL(label), text);
+		return;
+	}
+	gchar *markup = g_markup_printf_escaped("%s\n<small>%s</small>", text, sublabel);
+	gtk_label_set_markup(GTK_LABEL(label), markup);
+	g_free(markup);
+}
+
+// setMenuItemIcon shows the PNG image in the menu item image, scaled to the size of a menu icon
+static void setMenuItemIcon(GtkWidget *image, const guchar *buf, gsize len) {
+	GdkPixbufLoader *loader = gdk_pixbuf_loader_new();
+	if (!loader) {
+		return;
+	}
+	if (gdk_pixbuf_loader_write(loader, buf, len, NULL) && gdk_pixbuf_loader_close(loader, NULL)) {
+		GdkPixbuf *pixbuf = gdk_pixbuf_loader_get_pixbuf(loader);
+		if (pixbuf) {
+			gint size = 16;
+			gtk_icon_size_lookup(GTK_ICON_SIZE_MENU, &size, NULL);
+			gint width = gdk_pixbuf_get_width(pixbuf) * size / gdk_pixbuf_get_height(pixbuf);
+			GdkPixbuf *scaled = gdk_pixbuf_scale_simple(pixbuf, width, size, GDK_INTERP_BILINEAR);
+			gtk_image_set_from_pixbuf(GTK_IMAGE(image), scaled);
+			g_object_unref(scaled);
+		}
+	}
+	g_object_unref(loader);
+}
+
+static gboolean isDarkTheme() {
+	GtkSettings *settings = gtk_settings_get_default();
+	gboolean preferDark = FALSE;
+	gchar *themeName = NULL;
+	g_object_get(settings, "gtk-application-prefer-dark-theme", &preferDark, "gtk-theme-name", &themeName, NULL);
+	if (themeName != NULL) {
+		gchar *lower = g_ascii_strdown(themeName, -1);
+		preferDark = preferDark || strstr(lower, "dark") != NULL;
+		g_free(lower);
+		g_free(themeName);
+	}
+	return preferDark;
+}
+*/
+import "C"
+import (
+	"unsafe"
+
+	"github.com/wailsapp/wails/v2/pkg/menu"
+)
+
+// gtkMenuItem holds the widgets of a menu item that are updated when the menu is updated
+type gtkMenuItem struct {
+	widget *C.GtkWidget
+	label  *C.GtkWidget
+	// nil until the item has an icon
+	image *C.GtkWidget
+}
+
+// Set while the menu is updated so that changing the state of check menu items doesn't click them
+var updatingMenu bool
+
+func newGtkMenuItem(widget *C.GtkWidget) *gtkMenuItem {
+	return &gtkMenuItem{
+		widget: widget,
+		label:  C.menuItemLabel(widget),
+	}
+}
+
+// update sets the label, sublabel, tooltip, icon and state of the widgets
+func (g *gtkMenuItem) update(menuItem *menu.MenuItem) {
+	cLabel := C.CString(menuItem.Label)
+	cSublabel := C.CString(menuItem.Sublabel)
+	C.setMenuItemLabel(g.label, cLabel, cSublabel)
+	C.free(unsafe.Pointer(cLabel))
+	C.free(unsafe.Pointer(cSublabel))
+
+	if menuItem.Tooltip != "" {
+		cTooltip := C.CString(menuItem.Tooltip)
+		C.gtk_widget_set_tooltip_text(g.widget, cTooltip)
+		C.free(unsafe.Pointer(cTooltip))
+	} else {
+		C.gtk_widget_set_tooltip_text(g.widget, nil)
+	}
+
+	icon := menuItem.Icon
+	if len(menuItem.IconDark) > 0 && C.isDarkTheme() == 1 {
+		icon = menuItem.IconDark
+	}
+	if len(icon) > 0 {
+		if g.image == nil {
+			g.image = C.addMenuItemImage(g.widget, g.label)
+		}
+		C.setMenuItemIcon(g.image, (*C.guchar)(&icon[0]), (C.gsize)(len(icon)))
+	} else if g.image != nil {
+		C.gtk_image_clear(C.toGtkImage(unsafe.Pointer(g.image)))
+	}
+
+	C.gtk_widget_set_sensitive(g.widget, gtkBool(!menuItem.Disabled))
+
+	if menuItem.Type == menu.CheckboxType || menuItem.Type == menu.RadioType {
+		updatingMenu = true
+		C.gtk_check_menu_item_set_active(C.toGtkCheckMenuItem(unsafe.Pointer(g.widget)), gtkBool(menuItem.Checked))
+		updatingMenu = false
+	}
+}
@@ -0,0 +1,122 @@
+//go:build linux
+// +build linux
+
+package linux
+
+/*
+#cgo linux pkg-config: gtk+-3.0 webkit2gtk-4.0
+
+#include <gtk/gtk.h>
+#include <webkit2/webkit2.h>
+
+static void executeEditingCommand(void *webview, char *command) {
+	webkit_web_view_execute_editing_command(WEBKIT_WEB_VIEW(webview), command);
+}
+
+static void reload(void *webview) {
+	webkit_web_view_reload(WEBKIT_WEB_VIEW(webview));
+}
+
+static void showAboutDialog(void *window) {
+	GtkWindow *gtkWindow = GTK_WINDOW(window);
+	gtk_show_about_dialog(gtkWindow,
+		"program-name", gtk_window_get_title(gtkWindow),
+		"logo", gtk_window_get_icon(gtkWindow),
+		NULL);
+}
+*/
+import "C"
+import (
+	"unsafe"
+
+	"github.com/wailsapp/wails/v2/pkg/menu"
+)
+
+// The WebKit editing commands of the edit roles
+var editingCommands = map[menu.Role]string{
+	menu.UndoRole:               "Undo",
+	menu.RedoRole:               "Redo",
+	menu.CutRole:                "Cut",
+	menu.CopyRole:               "Copy",
+	menu.PasteRole:              "Paste",
+	menu.PasteAndMatchStyleRole: "PasteAsPlainText",
+	menu.SelectAllRole:          "SelectAll",
+	menu.DeleteRole:             "Delete",
+}
+
+// roleMenuItem returns the menu item that implements the role of the given item.
+// Returns nil if the role isn't supported on Linux.
+func (w *Window) roleMenuItem(item *menu.MenuItem) *menu.MenuItem {
+	role := item.Role
+	if role.IsMenu() {
+		submenu := role.Menu()
+		if submenu == nil {
+			return nil
+		}
+		return &menu.MenuItem{
+			Label:    role.Label(),
+			Type:     menu.SubmenuType,
+			SubMenu:  submenu,
+			Disabled: item.Disabled,
+		}
+	}
+
+	action := w.roleAction(role)
+	if action == nil {
+		return nil
+	}
+	return &menu.MenuItem{
+		Label:       role.Label(),
+		Type:        menu.TextType,
+		Accelerator: role.Accelerator(),
+		Disabled:    item.Disabled,
+		Click: func(*menu.CallbackData) {
+			action()
+		},
+	}
+}
+
+func (w *Window) roleAction(role menu.Role) func() {
+	if command, ok := editingCommands[role]; ok {
+		return func() {
+			invokeOnMainThread(func() {
+				cCommand := C.CString(command)
+				C.executeEditingCommand(w.webview, cCommand)
+				C.free(unsafe.Pointer(cCommand))
+			})
+		}
+	}
+
+	switch role {
+	case menu.AboutRole:
+		return func() {
+			invokeOnMainThread(func() { C.showAboutDialog(w.gtkWindow) })
+		}
+	case menu.MinimizeRole:
+		return w.Minimise
+	case menu.ZoomRole:
+		return w.ToggleMaximise
+	case menu.ToggleFullscreenRole:
+		return func() {
+			if w.IsFullScreen() {
+				w.UnFullscreen()
+			} else {
+				w.Fullscreen()
+			}
+		}
+	case menu.ReloadRole:
+		return func() {
+			invokeOnMainThread(func() { C.reload(w.webview) })
+		}
+	case menu.CloseWindowRole:
+		// Behaves like the close button of the window
+		return func() {
+			invokeOnMainThread(w.Close)
+		}
+	case menu.QuitRole:
+		return func() {
+			messageBuffer <- "Q"
+		}
+	}
+	return nil
+}
@@ -13,6 +13,8 @@ static float xroot = 0.0f;
 static float yroot = 0.0f;
 static int dragTime = -1;
 static uint mouseButton = 0;
+// The last button press on the webview, which triggers the context menus opened from the frontend
+static GdkEvent *lastButtonPress = NULL;
 
 // casts
 void ExecuteOnMainThread(void *f, gpointer jscallback)
@@ -227,6 +229,37 @@ void SetPosition(void *window, int x, int y)
     ExecuteOnMainThread(setPosition, (gpointer)args);
 }
 
+// The primary monitor is unknown on Wayland, in which case the origin of the screen is used
+static GdkRectangle getPrimaryMonitorGeometry(GtkWindow *window)
+{
+    GdkRectangle result = {0, 0, 0, 0};
+    GdkMonitor *monitor = gdk_display_get_primary_monitor(gtk_widget_get_display(GTK_WIDGET(window)));
+    if (monitor != NULL)
+    {
+        gdk_monitor_get_geometry(monitor, &result);
+    }
+    return result;
+}
+
+void SetAbsolutePosition(void *window, int x, int y)
+{
+    GdkRectangle primary = getPrimaryMonitorGeometry(window);
+    SetPositionArgs *args = malloc(sizeof(SetPositionArgs));
+    args->window = window;
+    args->x = primary.x + x;
+    args->y = primary.y + y;
+    ExecuteOnMainThread(setPosition, (gpointer)args);
+}
+
+// Must be called on the main thread
+void GetAbsolutePosition(void *window, int *x, int *y)
+{
+    GdkRectangle primary = getPrimaryMonitorGeometry(window);
+    gtk_window_get_position((GtkWindow *)window, x, y);
+    *x -= primary.x;
+    *y -= primary.y;
+}
+
 void SetMinMaxSize(GtkWindow *window, int min_width, int min_height, int max_width, int max_height)
 {
     GdkGeometry size;
@@ -267,6 +300,11 @@ static gboolean buttonPress(GtkWidget *widget, GdkEventButton *event, void *dumm
         return FALSE;
     }
     mouseButton = event->button;
+    if (lastButtonPress != NULL)
+    {
+        gdk_event_free(lastButtonPress);
+    }
+    lastButtonPress = gdk_event_copy((GdkEvent *)event);
     if (event->button == 3)
     {
         return FALSE;
@@ -292,6 +330,11 @@ static gboolean buttonRelease(GtkWidget *widget, GdkEventButton *event, void *du
     return FALSE;
 }
 
+GdkEvent *LastButtonPress()
+{
+    return lastButtonPress;
+}
+
 void ConnectButtons(void *webview)
 {
     g_signal_connect(WEBKIT_WEB_VIEW(webview), "button-press-event", G_CALLBACK(buttonPress), NULL);
@@ -418,6 +461,18 @@ static void webviewLoadChanged(WebKitWebView *web_view, WebKitLoadEvent load_eve
     }
 }
 
+static void webviewProcessTerminated(WebKitWebView *web_view, WebKitWebProcessTerminationReason reason, gpointer data)
+{
+    if (reason == WEBKIT_WEB_PROCESS_CRASHED)
+    {
+        processMessage("wails:webProcessTerminated:crashed");
+    }
+    else if (reason == WEBKIT_WEB_PROCESS_EXCEEDED_MEMORY_LIMIT)
+    {
+        processMessage("wails:webProcessTerminated:exceeded the memory limit");
+    }
+}
+
 extern void processURLRequest(void *request);
 
 // This is called when the close button on the window is pressed
@@ -437,6 +492,7 @@ GtkWidget *SetupWebview(void *contentManager, GtkWindow *window, int hideWindowO
     WebKitWebContext *context = webkit_web_context_get_default();
     webkit_web_context_register_uri_scheme(context, "wails", (WebKitURISchemeRequestCallback)processURLRequest, NULL, NULL);
     g_signal_connect(G_OBJECT(webview), "load-changed", G_CALLBACK(webviewLoadChanged), NULL);
+    g_signal_connect(G_OBJECT(webview), "web-process-terminated", G_CALLBACK(webviewProcessTerminated), NULL);
     if (hideWindowOnClose)
     {
         g_signal_connect(GTK_WIDGET(window), "delete-event", G_CALLBACK(gtk_widget_hide_on_delete), NULL);
@@ -42,6 +42,7 @@ type Window struct {
 	contentManager                           unsafe.Pointer
 	webview                                  unsafe.Pointer
 	applicationMenu                          *menu.Menu
+	menuStructure                            []menuStructureItem
 	menubar                                  *C.GtkWidget
 	webviewBox                               *C.GtkWidget
 	vbox                                     *C.GtkWidget
@@ -188,6 +189,12 @@ func (w *Window) SetPosition(x int, y int) {
 	})
 }
 
+func (w *Window) SetAbsolutePosition(x int, y int) {
+	invokeOnMainThread(func() {
+		C.SetAbsolutePosition(unsafe.Pointer(w.asGTKWindow()), C.int(x), C.int(y))
+	})
+}
+
 func (w *Window) Size() (int, int) {
 	var width, height C.int
 	var wg sync.WaitGroup
@@ -212,6 +219,18 @@ func (w *Window) GetPosition() (int, int) {
 	return int(width), int(height)
 }
 
+func (w *Window) GetAbsolutePosition() (int, int) {
+	var x, y C.int
+	var wg sync.WaitGroup
+	wg.Add(1)
+	invokeOnMainThread(func() {
+		C.GetAbsolutePosition(unsafe.Pointer(w.asGTKWindow()), &x, &y)
+		wg.Done()
+	})
+	wg.Wait()
+	return int(x), int(y)
+}
+
 func (w *Window) SetMaxSize(maxWidth int, maxHeight int) {
 	w.maxHeight = maxHeight
 	w.maxWidth = maxWidth
@@ -0,0 +1,74 @@
+//go:build linux
+// +build linux
+
+package linux
+
+/*
+#cgo linux pkg-config: gtk+-3.0
+
+#include "gtk/gtk.h"
+
+extern void handleWindowConfigure(int x, int y, int width, int height);
+extern void handleWindowState(gboolean minimised, gboolean maximised, gboolean fullscreen);
+extern void handleWindowFocus(gboolean focused);
+
+static gboolean windowConfigure(GtkWidget *widget, GdkEventConfigure *event, gpointer data) {
+	int x, y, width, height;
+	gtk_window_get_position(GTK_WINDOW(widget), &x, &y);
+	gtk_window_get_size(GTK_WINDOW(widget), &width, &height);
+	handleWindowConfigure(x, y, width, height);
+	return FALSE;
+}
+
+static gboolean windowState(GtkWidget *widget, GdkEventWindowState *event, gpointer data) {
+	GdkWindowState state = event->new_window_state;
+	handleWindowState((state & GDK_WINDOW_STATE_ICONIFIED) != 0, (state & GDK_WINDOW_STATE_MAXIMIZED) != 0, (state & GDK_WINDOW_STATE_FULLSCREEN) != 0);
+	return FALSE;
+}
+
+static gboolean windowFocusIn(GtkWidget *widget, GdkEventFocus *event, gpointer data) {
+	handleWindowFocus(TRUE);
+	return FALSE;
+}
+
+static gboolean windowFocusOut(GtkWidget *widget, GdkEventFocus *event, gpointer data) {
+	handleWindowFocus(FALSE);
+	return FALSE;
+}
+
+static void connectWindowEvents(void *window) {
+	g_signal_connect(GTK_WIDGET(window), "configure-event", G_CALLBACK(windowConfigure), NULL);
+	g_signal_connect(GTK_WIDGET(window), "window-state-event", G_CALLBACK(windowState), NULL);
+	g_signal_connect(GTK_WIDGET(window), "focus-in-event", G_CALLBACK(windowFocusIn), NULL);
+	g_signal_connect(GTK_WIDGET(window), "focus-out-event", G_CALLBACK(windowFocusOut), NULL);
+}
+*/
+import "C"
+import (
+	"github.com/wailsapp/wails/v2/internal/frontend"
+)
+
+// windowEvents emits the resize, move, focus and state events of the main window
+var windowEvents *frontend.WindowEvents
+
+// connectWindowEvents emits the window events of the main window
+func connectWindowEvents(window *Window, events frontend.Events) {
+	windowEvents = frontend.NewWindowEvents(events)
+	C.connectWindowEvents(window.gtkWindow)
+}
+
+//export handleWindowConfigure
+func handleWindowConfigure(x C.int, y C.int, width C.int, height C.int) {
+	windowEvents.Moved(int(x), int(y))
+	windowEvents.Resized(int(width), int(height))
+}
+
+//export handleWindowState
+func handleWindowState(minimised C.gboolean, maximised C.gboolean, fullscreen C.gboolean) {
+	windowEvents.StateChanged(minimised != 0, maximised != 0, fullscreen != 0)
+}
+
+//export handleWindowFocus
+func handleWindowFocus(focused C.gboolean) {
+	windowEvents.FocusChanged(focused != 0)
+}
@@ -20,6 +20,7 @@ import (
 	"github.com/bep/debounce"
 	"github.com/wailsapp/go-webview2/pkg/edge"
 	"github.com/wailsapp/wails/v2/internal/binding"
+	"github.com/wailsapp/wails/v2/internal/crashreporter"
 	"github.com/wailsapp/wails/v2/internal/frontend"
 	"github.com/wailsapp/wails/v2/internal/frontend/desktop/windows/win32"
 	"github.com/wailsapp/wails/v2/internal/frontend/desktop/windows/winc"
@@ -29,6 +30,7 @@ import (
 	"github.com/wailsapp/wails/v2/internal/system/operatingsystem"
 	"github.com/wailsapp/wails/v2/pkg/assetserver"
 	"github.com/wailsapp/wails/v2/pkg/assetserver/webview"
+	"github.com/wailsapp/wails/v2/pkg/crashreport"
 	"github.com/wailsapp/wails/v2/pkg/options"
 	"github.com/wailsapp/wails/v2/pkg/options/windows"
 )
@@ -138,6 +140,9 @@ func (f *Frontend) Run(ctx context.Context) error {
 	f.chromium = edge.NewChromium()
 
 	mainWindow := NewWindow(nil, f.frontendOptions, f.versionInfo, f.chromium)
+	if events, _ := ctx.Value("events").(frontend.Events); events != nil {
+		mainWindow.windowEvents = frontend.NewWindowEvents(events)
+	}
 	f.mainWindow = mainWindow
 
 	var _debug = ctx.Value("debug")
@@ -225,6 +230,19 @@ func (f *Frontend) WindowGetPosition() (int, int) {
 	return f.mainWindow.Pos()
 }
 
+func (f *Frontend) WindowSetAbsolutePosition(x, y int) {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	// The primary screen is at the origin of the virtual screen
+	w32.SetWindowPos(f.mainWindow.Handle(), w32.HWND_TOP, x, y, 0, 0, w32.SWP_NOSIZE|w32.SWP_NOZORDER)
+}
+
+func (f *Frontend) WindowGetAbsolutePosition() (int, int) {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	return f.mainWindow.Pos()
+}
+
 func (f *Frontend) WindowSetSize(width, height int) {
 	runtime.LockOSThread()
 	defer runtime.UnlockOSThread()
@@ -479,6 +497,9 @@ func (f *Frontend) setupChromium() {
 		}
 
 		f.logger.Error("WebVie2wProcess failed with kind %d", kind)
+		if reporter, ok := f.ctx.Value("crashreporter").(*crashreporter.Reporter); ok {
+			reporter.ReportCrash(crashreport.SourceWebView, fmt.Sprintf("WebView2 process failed with kind %d", kind))
+		}
 		switch kind {
 		case edge.COREWEBVIEW2_PROCESS_FAILED_KIND_BROWSER_PROCESS_EXITED:
 			// => The app has to recreate a new WebView to recover from this failure.
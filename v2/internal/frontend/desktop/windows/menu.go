@@ -47,20 +47,32 @@ func (w *Window) SetApplicationMenu(menu *menu.Menu) {
 func processMenu(window *Window, menu *menu.Menu) {
 	mainMenu := window.NewMenu()
 	for _, menuItem := range menu.Items {
+		if menuItem.Role != 0 {
+			menuItem = window.roleMenuItem(menuItem)
+			if menuItem == nil {
+				continue
+			}
+		}
 		submenu := mainMenu.AddSubMenu(menuItem.Label)
 		if menuItem.SubMenu != nil {
 			for _, menuItem := range menuItem.SubMenu.Items {
-				processMenuItem(submenu, menuItem)
+				processMenuItem(window, submenu, menuItem)
 			}
 		}
 	}
 	mainMenu.Show()
 }
 
-func processMenuItem(parent *winc.MenuItem, menuItem *menu.MenuItem) {
+func processMenuItem(window *Window, parent *winc.MenuItem, menuItem *menu.MenuItem) {
 	if menuItem.Hidden {
 		return
 	}
+	if menuItem.Role != 0 {
+		menuItem = window.roleMenuItem(menuItem)
+		if menuItem == nil {
+			return
+		}
+	}
 	switch menuItem.Type {
 	case menu.SeparatorType:
 		parent.AddSeparator()
@@ -118,7 +130,7 @@ func processMenuItem(parent *winc.MenuItem, menuItem *menu.MenuItem) {
 	case menu.SubmenuType:
 		submenu := parent.AddSubMenu(menuItem.Label)
 		for _, menuItem := range menuItem.SubMenu.Items {
-			processMenuItem(submenu, menuItem)
+			processMenuItem(window, submenu, menuItem)
 		}
 	}
 }
@@ -130,3 +142,7 @@ func (f *Frontend) MenuSetApplicationMenu(menu *menu.Menu) {
 func (f *Frontend) MenuUpdateApplicationMenu() {
 	processMenu(f.mainWindow, f.mainWindow.applicationMenu)
 }
+
+func (f *Frontend) MenuOpenContextMenu(id string, _ string, _ int, _ int) {
+	f.logger.Warning("context menu '%s' not opened: context menus are not yet supported on Windows", id)
+}
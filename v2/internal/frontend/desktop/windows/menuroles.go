@@ -0,0 +1,84 @@
+//go:build windows
+// +build windows
+
+package windows
+
+import (
+	"github.com/wailsapp/wails/v2/internal/frontend/desktop/windows/winc"
+	"github.com/wailsapp/wails/v2/internal/frontend/desktop/windows/winc/w32"
+	"github.com/wailsapp/wails/v2/pkg/menu"
+)
+
+// selectedTextJS evaluates to the selected text of the page or the focused input
+const selectedTextJS = `(function(){const e=document.activeElement;if(e&&(e.tagName==="INPUT"||e.tagName==="TEXTAREA")){return e.value.substring(e.selectionStart,e.selectionEnd);}return window.getSelection().toString();})()`
+
+// The scripts of the edit roles. WebView2 has no editing commands, so the clipboard is accessed through the runtime.
+var editingScripts = map[menu.Role]string{
+	menu.UndoRole:               `document.execCommand("undo");`,
+	menu.RedoRole:               `document.execCommand("redo");`,
+	menu.CutRole:                `window.runtime.ClipboardSetText(` + selectedTextJS + `);document.execCommand("delete");`,
+	menu.CopyRole:               `window.runtime.ClipboardSetText(` + selectedTextJS + `);`,
+	menu.PasteRole:              `window.runtime.ClipboardGetText().then(function(text){document.execCommand("insertText",false,text);});`,
+	menu.PasteAndMatchStyleRole: `window.runtime.ClipboardGetText().then(function(text){document.execCommand("insertText",false,text);});`,
+	menu.SelectAllRole:          `document.execCommand("selectAll");`,
+	menu.DeleteRole:             `document.execCommand("delete");`,
+}
+
+// The scripts of the window roles. They use the runtime so they behave like the runtime methods.
+var windowScripts = map[menu.Role]string{
+	menu.MinimizeRole:         `window.runtime.WindowMinimise();`,
+	menu.ZoomRole:             `window.runtime.WindowToggleMaximise();`,
+	menu.ToggleFullscreenRole: `window.runtime.WindowIsFullscreen().then(function(fullscreen){fullscreen?window.runtime.WindowUnfullscreen():window.runtime.WindowFullscreen();});`,
+	menu.ReloadRole:           `window.runtime.WindowReload();`,
+	menu.QuitRole:             `window.runtime.Quit();`,
+}
+
+// roleMenuItem returns the menu item that implements the role of the given item.
+// Returns nil if the role isn't supported on Windows.
+func (w *Window) roleMenuItem(item *menu.MenuItem) *menu.MenuItem {
+	role := item.Role
+	if role.IsMenu() {
+		submenu := role.Menu()
+		if submenu == nil {
+			return nil
+		}
+		return &menu.MenuItem{
+			Label:    role.Label(),
+			Type:     menu.SubmenuType,
+			SubMenu:  submenu,
+			Disabled: item.Disabled,
+		}
+	}
+
+	result := &menu.MenuItem{
+		Label:       role.Label(),
+		Type:        menu.TextType,
+		Accelerator: role.Accelerator(),
+		Disabled:    item.Disabled,
+	}
+	if script, ok := editingScripts[role]; ok {
+		// The WebView handles the editing shortcuts itself
+		result.Accelerator = nil
+		result.Click = func(*menu.CallbackData) { w.chromium.Eval(script) }
+		return result
+	}
+	if script, ok := windowScripts[role]; ok {
+		result.Click = func(*menu.CallbackData) { w.chromium.Eval(script) }
+		return result
+	}
+
+	switch role {
+	case menu.AboutRole:
+		result.Click = func(*menu.CallbackData) {
+			winc.MsgBoxOk(w, "About "+w.Text(), w.Text())
+		}
+	case menu.CloseWindowRole:
+		// Behaves like the close button of the window
+		result.Click = func(*menu.CallbackData) {
+			w32.PostMessage(w.Handle(), w32.WM_CLOSE, 0, 0)
+		}
+	default:
+		return nil
+	}
+	return result
+}
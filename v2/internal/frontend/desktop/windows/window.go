@@ -7,6 +7,7 @@ import (
 	"sync"
 	"unsafe"
 
+	"github.com/wailsapp/wails/v2/internal/frontend"
 	"github.com/wailsapp/wails/v2/internal/frontend/desktop/windows/win32"
 	"github.com/wailsapp/wails/v2/internal/system/operatingsystem"
 
@@ -36,6 +37,9 @@ type Window struct {
 	OnSuspend func()
 	OnResume  func()
 
+	// windowEvents emits the resize, move, focus and state events of the window
+	windowEvents *frontend.WindowEvents
+
 	chromium *edge.Chromium
 }
 
@@ -208,6 +212,16 @@ func (w *Window) WndProc(msg uint32, wparam, lparam uintptr) uintptr {
 		w32.SetFocus(w.Handle())
 	case w32.WM_MOVE, w32.WM_MOVING:
 		w.chromium.NotifyParentWindowPositionChanged()
+		if msg == w32.WM_MOVE && w.windowEvents != nil {
+			w.windowEvents.Moved(w.Pos())
+		}
+	case w32.WM_SIZE:
+		if w.windowEvents != nil {
+			w.windowEvents.StateChanged(w.IsMinimised(), w.IsMaximised(), w.IsFullScreen())
+			if wparam != w32.SIZE_MINIMIZED {
+				w.windowEvents.Resized(w.Size())
+			}
+		}
 	case w32.WM_ACTIVATE:
 		//if !w.frontendOptions.Frameless {
 		w.themeChanged = true
@@ -219,6 +233,9 @@ func (w *Window) WndProc(msg uint32, wparam, lparam uintptr) uintptr {
 			w.UpdateTheme()
 			//}
 		}
+		if w.windowEvents != nil {
+			w.windowEvents.FocusChanged(w.isActive)
+		}
 
 	case 0x02E0: //w32.WM_DPICHANGED
 		newWindowSize := (*w32.RECT)(unsafe.Pointer(lparam))
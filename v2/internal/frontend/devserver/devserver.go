@@ -101,6 +101,8 @@ func (d *DevWebServer) Run(ctx context.Context) error {
 	if err != nil {
 		log.Fatal(err)
 	}
+	assetServer.UseSecurityHeaders(assetServerConfig.SecurityHeaders)
+	assetServer.UseSPAFallback(assetServerConfig.SPAFallback)
 
 	d.server.Any("/*", func(c echo.Context) error {
 		if c.IsWebSocket() {
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/wailsapp/wails/v2/internal/frontend"
 )
@@ -23,6 +24,7 @@ func (d *Dispatcher) processCallMessage(message string, sender frontend.Frontend
 	}
 
 	var result interface{}
+	start := time.Now()
 
 	// Handle different calls
 	switch true {
@@ -34,17 +36,21 @@ func (d *Dispatcher) processCallMessage(message string, sender frontend.Frontend
 
 		// Check we have it
 		if registeredMethod == nil {
-			return "", fmt.Errorf("method '%s' not registered", payload.Name)
+			err = fmt.Errorf("method '%s' not registered", payload.Name)
+			d.logCall(payload.Name, start, err)
+			return "", err
 		}
 
 		args, err2 := registeredMethod.ParseArgs(payload.Args)
 		if err2 != nil {
 			errmsg := fmt.Errorf("error parsing arguments: %s", err2.Error())
+			d.logCall(payload.Name, start, errmsg)
 			result, _ := d.NewErrorCallback(errmsg.Error(), payload.CallbackID)
 			return result, errmsg
 		}
-		result, err = registeredMethod.Call(args)
+		result, err = d.callMethod(registeredMethod, args)
 	}
+	d.logCall(payload.Name, start, err)
 
 	callbackMessage := &CallbackMessage{
 		CallbackID: payload.CallbackID,
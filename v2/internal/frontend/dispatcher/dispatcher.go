@@ -2,32 +2,78 @@ package dispatcher
 
 import (
 	"context"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/wailsapp/wails/v2/internal/binding"
 	"github.com/wailsapp/wails/v2/internal/frontend"
 	"github.com/wailsapp/wails/v2/internal/logger"
+	"github.com/wailsapp/wails/v2/internal/menumanager"
+	"github.com/wailsapp/wails/v2/pkg/crashreport"
+	pkgLogger "github.com/wailsapp/wails/v2/pkg/logger"
 	"github.com/wailsapp/wails/v2/pkg/options"
 )
 
 type Dispatcher struct {
 	log        *logger.Logger
+	structured *logger.Structured
+	frontend   *logger.Structured // Logs messages sent by the frontend
 	bindings   *binding.Bindings
 	events     frontend.Events
 	bindingsDB *binding.DB
 	ctx        context.Context
 	errfmt     options.ErrorFormatter
+
+	// Reports panics in bound methods. May be nil
+	crashReporter crashReporter
+
+	// Handles the key bindings pressed in the frontend. May be nil
+	keyBindings keyBindings
+
+	// Provides the application menu to the frontend. May be nil
+	applicationMenu applicationMenu
+}
+
+type crashReporter interface {
+	Recover(source string)
+}
+
+type keyBindings interface {
+	Notify()
+	Trigger(id string) error
+}
+
+type applicationMenu interface {
+	GetFrontendApplicationMenu() []*menumanager.FrontendMenuItem
+	ProcessApplicationMenuClick(menuID string, sender frontend.Frontend) error
 }
 
 func NewDispatcher(ctx context.Context, log *logger.Logger, bindings *binding.Bindings, events frontend.Events, errfmt options.ErrorFormatter) *Dispatcher {
+	reporter, _ := ctx.Value("crashreporter").(crashReporter)
+	keyBindingHandler, _ := ctx.Value("keybindings").(keyBindings)
+	menuManager, _ := ctx.Value("menumanager").(applicationMenu)
 	return &Dispatcher{
 		log:        log,
+		structured: log.Structured().With("component", "Dispatcher"),
+		frontend:   log.Structured().With("source", "frontend"),
 		bindings:   bindings,
 		events:     events,
 		bindingsDB: bindings.DB(),
 		ctx:        ctx,
 		errfmt:     errfmt,
+
+		crashReporter:   reporter,
+		keyBindings:     keyBindingHandler,
+		applicationMenu: menuManager,
+	}
+}
+
+// callMethod calls a bound method, reporting any panic with the crash reporter
+func (d *Dispatcher) callMethod(method *binding.BoundMethod, args []interface{}) (interface{}, error) {
+	if d.crashReporter != nil {
+		defer d.crashReporter.Recover(crashreport.SourceBoundMethod)
 	}
+	return method.Call(args)
 }
 
 func (d *Dispatcher) ProcessMessage(message string, sender frontend.Frontend) (string, error) {
@@ -47,6 +93,10 @@ func (d *Dispatcher) ProcessMessage(message string, sender frontend.Frontend) (s
 		return d.processWindowMessage(message, sender)
 	case 'B':
 		return d.processBrowserMessage(message, sender)
+	case 'M':
+		return d.processMenuMessage(message, sender)
+	case 'K':
+		return d.processKeyBindingMessage(message)
 	case 'Q':
 		sender.Quit()
 		return "", nil
@@ -60,3 +110,13 @@ func (d *Dispatcher) ProcessMessage(message string, sender frontend.Frontend) (s
 		return "", errors.New("Unknown message from front end: " + message)
 	}
 }
+
+// logCall logs the result of a call to a bound or system method
+func (d *Dispatcher) logCall(method string, start time.Time, err error) {
+	duration := time.Since(start)
+	if err != nil {
+		d.structured.Log(d.ctx, pkgLogger.DEBUG, "call failed", "method", method, "duration", duration, "error", err)
+		return
+	}
+	d.structured.Log(d.ctx, pkgLogger.TRACE, "call", "method", method, "duration", duration)
+}
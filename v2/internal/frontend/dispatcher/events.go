@@ -3,7 +3,9 @@ package dispatcher
 import (
 	"encoding/json"
 	"errors"
+
 	"github.com/wailsapp/wails/v2/internal/frontend"
+	pkgLogger "github.com/wailsapp/wails/v2/pkg/logger"
 )
 
 type EventMessage struct {
@@ -23,9 +25,11 @@ func (d *Dispatcher) processEventMessage(message string, sender frontend.Fronten
 		if err != nil {
 			return "", err
 		}
+		d.structured.Log(d.ctx, pkgLogger.TRACE, "event emitted", "event", eventMessage.Name)
 		go d.events.Notify(sender, eventMessage.Name, eventMessage.Data...)
 	case 'X':
 		eventName := message[2:]
+		d.structured.Log(d.ctx, pkgLogger.TRACE, "event listeners removed", "event", eventName)
 		go d.events.Off(eventName)
 	}
 
@@ -0,0 +1,26 @@
+package dispatcher
+
+import "errors"
+
+// processKeyBindingMessage processes key binding messages
+func (d *Dispatcher) processKeyBindingMessage(message string) (string, error) {
+	if len(message) < 2 {
+		return "", errors.New("Invalid KeyBinding Message: " + message)
+	}
+	if d.keyBindings == nil {
+		return "", nil
+	}
+	switch message[1] {
+	case 'L':
+		// The runtime has loaded and needs the key bindings
+		d.keyBindings.Notify()
+	case 'P':
+		if err := d.keyBindings.Trigger(message[2:]); err != nil {
+			return "", err
+		}
+	default:
+		d.log.Error("unknown KeyBinding message: %s", message)
+	}
+
+	return "", nil
+}
@@ -1,9 +1,13 @@
 package dispatcher
 
 import (
+	"encoding/json"
+	"sort"
+
 	"github.com/pkg/errors"
 	"github.com/wailsapp/wails/v2/internal/logger"
 	pkgLogger "github.com/wailsapp/wails/v2/pkg/logger"
+	"github.com/wailsapp/wails/v2/pkg/options"
 )
 
 var logLevelMap = map[byte]logger.LogLevel{
@@ -14,6 +18,41 @@ var logLevelMap = map[byte]logger.LogLevel{
 	'5': pkgLogger.ERROR,
 }
 
+var frontendLogLevels = map[byte]logger.LogLevel{
+	'T': pkgLogger.TRACE,
+	'D': pkgLogger.DEBUG,
+	'I': pkgLogger.INFO,
+	'W': pkgLogger.WARNING,
+	'E': pkgLogger.ERROR,
+	'F': logger.FATAL,
+}
+
+// structuredLogMessage is a log message with attributes sent by the frontend
+type structuredLogMessage struct {
+	Level   string                 `json:"level"`
+	Message string                 `json:"message"`
+	Attrs   map[string]interface{} `json:"attrs"`
+}
+
+// frontendLogCaptureConfig tells the frontend which console output and errors to capture
+type frontendLogCaptureConfig struct {
+	Level     logger.LogLevel `json:"level"`
+	RateLimit int             `json:"rateLimit"`
+}
+
+// frontendLogCapture returns the capture configuration for the frontend, or nil if capturing is disabled
+func (d *Dispatcher) frontendLogCapture() *frontendLogCaptureConfig {
+	capture, _ := d.ctx.Value("frontendLogCapture").(*options.FrontendLogCapture)
+	if capture == nil {
+		return nil
+	}
+	debug, _ := d.ctx.Value("debug").(bool)
+	return &frontendLogCaptureConfig{
+		Level:     capture.Level(debug),
+		RateLimit: capture.RateLimit,
+	}
+}
+
 func (d *Dispatcher) processLogMessage(message string) (string, error) {
 	if len(message) < 3 {
 		return "", errors.New("Invalid Log Message: " + message)
@@ -22,20 +61,10 @@ func (d *Dispatcher) processLogMessage(message string) (string, error) {
 	messageText := message[2:]
 
 	switch message[1] {
-	case 'T':
-		d.log.Trace(messageText)
 	case 'P':
 		d.log.Print(messageText)
-	case 'D':
-		d.log.Debug(messageText)
-	case 'I':
-		d.log.Info(messageText)
-	case 'W':
-		d.log.Warning(messageText)
-	case 'E':
-		d.log.Error(messageText)
-	case 'F':
-		d.log.Fatal(messageText)
+	case 'J':
+		return "", d.processStructuredLogMessage(messageText)
 	case 'S':
 		loglevel, exists := logLevelMap[message[2]]
 		if !exists {
@@ -43,7 +72,39 @@ func (d *Dispatcher) processLogMessage(message string) (string, error) {
 		}
 		d.log.SetLogLevel(loglevel)
 	default:
-		return "", errors.New("Invalid Log Message: " + message)
+		level, exists := frontendLogLevels[message[1]]
+		if !exists {
+			return "", errors.New("Invalid Log Message: " + message)
+		}
+		d.frontend.Log(d.ctx, level, messageText)
 	}
 	return "", nil
 }
+
+func (d *Dispatcher) processStructuredLogMessage(data string) error {
+	var message structuredLogMessage
+	if err := json.Unmarshal([]byte(data), &message); err != nil {
+		return errors.Wrap(err, "Invalid Structured Log Message")
+	}
+	if len(message.Level) != 1 {
+		return errors.New("Invalid Structured Log Message level: " + message.Level)
+	}
+	level, exists := frontendLogLevels[message.Level[0]]
+	if !exists {
+		return errors.New("Invalid Structured Log Message level: " + message.Level)
+	}
+
+	// Sort the attributes so they are always logged in the same order
+	keys := make([]string, 0, len(message.Attrs))
+	for key := range message.Attrs {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	args := make([]interface{}, 0, 2*len(keys))
+	for _, key := range keys {
+		args = append(args, key, message.Attrs[key])
+	}
+
+	d.frontend.Log(d.ctx, level, message.Message, args...)
+	return nil
+}
@@ -0,0 +1,42 @@
+package dispatcher
+
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/wailsapp/wails/v2/internal/frontend"
+)
+
+type contextMenuMessage struct {
+	ID   string `json:"id"`
+	Data string `json:"data"`
+	X    int    `json:"x"`
+	Y    int    `json:"y"`
+}
+
+// processMenuMessage processes menu messages
+func (d *Dispatcher) processMenuMessage(message string, sender frontend.Frontend) (string, error) {
+	if len(message) < 2 {
+		return "", errors.New("Invalid Menu Message: " + message)
+	}
+	switch message[1] {
+	case 'C':
+		var contextMenu contextMenuMessage
+		if err := json.Unmarshal([]byte(message[2:]), &contextMenu); err != nil {
+			return "", err
+		}
+		go sender.MenuOpenContextMenu(contextMenu.ID, contextMenu.Data, contextMenu.X, contextMenu.Y)
+	case 'A':
+		// An application menu item drawn by the frontend has been clicked
+		if d.applicationMenu == nil {
+			return "", nil
+		}
+		if err := d.applicationMenu.ProcessApplicationMenuClick(message[2:], sender); err != nil {
+			return "", err
+		}
+	default:
+		d.log.Error("unknown Menu message: %s", message)
+	}
+
+	return "", nil
+}
@@ -3,6 +3,9 @@ package dispatcher
 import (
 	"encoding/json"
 	"fmt"
+	"strconv"
+	"time"
+
 	"github.com/wailsapp/wails/v2/internal/frontend"
 )
 
@@ -21,22 +24,29 @@ func (d *Dispatcher) processSecureCallMessage(message string, sender frontend.Fr
 	}
 
 	var result interface{}
+	start := time.Now()
+	// Obfuscated methods are logged by ID so the logs don't reveal their names
+	method := strconv.Itoa(payload.ID)
 
 	// Lookup method
 	registeredMethod := d.bindingsDB.GetObfuscatedMethod(payload.ID)
 
 	// Check we have it
 	if registeredMethod == nil {
-		return "", fmt.Errorf("method '%d' not registered", payload.ID)
+		err = fmt.Errorf("method '%d' not registered", payload.ID)
+		d.logCall(method, start, err)
+		return "", err
 	}
 
 	args, err2 := registeredMethod.ParseArgs(payload.Args)
 	if err2 != nil {
 		errmsg := fmt.Errorf("error parsing arguments: %s", err2.Error())
+		d.logCall(method, start, errmsg)
 		result, _ := d.NewErrorCallback(errmsg.Error(), payload.CallbackID)
 		return result, errmsg
 	}
-	result, err = registeredMethod.Call(args)
+	result, err = d.callMethod(registeredMethod, args)
+	d.logCall(method, start, err)
 
 	callbackMessage := &CallbackMessage{
 		CallbackID: payload.CallbackID,
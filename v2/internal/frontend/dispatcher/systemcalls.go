@@ -8,6 +8,7 @@ import (
 	"strings"
 
 	"github.com/wailsapp/wails/v2/internal/frontend"
+	"github.com/wailsapp/wails/v2/internal/menumanager"
 )
 
 const systemCallPrefix = ":wails:"
@@ -46,6 +47,15 @@ func (d *Dispatcher) processSystemCall(payload callMessage, sender frontend.Fron
 		return sender.WindowIsFullscreen(), nil
 	case "Environment":
 		return runtime.Environment(d.ctx), nil
+	case "BuildInfo":
+		return runtime.ReadBuildInfo(d.ctx), nil
+	case "FrontendLogCapture":
+		return d.frontendLogCapture(), nil
+	case "MenuGetApplicationMenu":
+		if d.applicationMenu == nil {
+			return []*menumanager.FrontendMenuItem{}, nil
+		}
+		return d.applicationMenu.GetFrontendApplicationMenu(), nil
 	case "ClipboardGetText":
 		t, err := sender.ClipboardGetText()
 		return t, err
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/wailsapp/wails/v2/pkg/menu"
+	"github.com/wailsapp/wails/v2/pkg/menu/keys"
 	"github.com/wailsapp/wails/v2/pkg/options"
 )
 
@@ -104,6 +105,10 @@ type Frontend interface {
 	WindowSetAlwaysOnTop(b bool)
 	WindowSetPosition(x int, y int)
 	WindowGetPosition() (int, int)
+	// WindowSetAbsolutePosition and WindowGetAbsolutePosition use coordinates relative to the
+	// top left corner of the primary screen, whichever screen the window is on
+	WindowSetAbsolutePosition(x int, y int)
+	WindowGetAbsolutePosition() (int, int)
 	WindowSetSize(width int, height int)
 	WindowGetSize() (int, int)
 	WindowSetMinSize(width int, height int)
@@ -129,6 +134,11 @@ type Frontend interface {
 	// Menus
 	MenuSetApplicationMenu(menu *menu.Menu)
 	MenuUpdateApplicationMenu()
+	MenuOpenContextMenu(id string, data string, x int, y int)
+
+	// Hotkeys
+	HotkeyRegister(accelerator *keys.Accelerator, callback func()) (int, error)
+	HotkeyUnregister(id int) error
 
 	// Events
 	Notify(name string, data ...interface{})
@@ -0,0 +1,10 @@
+package frontend
+
+import "errors"
+
+var (
+	// ErrHotkeyInUse is returned when a hotkey is already registered by this or another application
+	ErrHotkeyInUse = errors.New("hotkey is already in use")
+	// ErrHotkeysNotSupported is returned when global hotkeys are not supported on the platform
+	ErrHotkeysNotSupported = errors.New("global hotkeys are not supported on this platform")
+)
@@ -0,0 +1,177 @@
+/*
+ _       __      _ __
+| |     / /___ _(_) /____
+| | /| / / __ `/ / / ___/
+| |/ |/ / /_/ / / (__  )
+|__/|__/\__,_/_/_/____/
+The electron alternative for Go
+(c) Lea Anthony 2019-present
+*/
+
+/* jshint esversion: 9 */
+
+import {Call} from './calls';
+
+// The log level of each captured console method
+const consoleLevels = {
+	trace: 1,
+	debug: 2,
+	log: 3,
+	info: 3,
+	warn: 4,
+	error: 5,
+};
+
+const levelCodes = ['', 'T', 'D', 'I', 'W', 'E'];
+
+// Logs captured before the backend has sent the configuration
+const maxPending = 100;
+let pending = [];
+
+// The capture configuration: undefined until received, null if capturing is disabled
+let config;
+
+let tokens = 0;
+let lastRefill = 0;
+let dropped = 0;
+let sending = false;
+
+const originalConsole = {};
+
+function formatValue(value) {
+	if (value instanceof Error) {
+		return value.message;
+	}
+	if (typeof value === 'string') {
+		return value;
+	}
+	try {
+		return JSON.stringify(value);
+	} catch (e) {
+		return String(value);
+	}
+}
+
+/**
+ * Sends a captured log to the backend, limited to config.rateLimit logs per second
+ *
+ * @param {number} level
+ * @param {string} message
+ * @param {Object<string, any>} attrs
+ */
+function send(level, message, attrs) {
+	const now = Date.now();
+	tokens = Math.min(config.rateLimit, tokens + (now - lastRefill) * config.rateLimit / 1000);
+	lastRefill = now;
+	if (tokens < 1) {
+		dropped++;
+		return;
+	}
+	tokens--;
+
+	if (dropped > 0) {
+		attrs.dropped = dropped;
+		dropped = 0;
+	}
+
+	sending = true;
+	try {
+		window.WailsInvoke('LJ' + JSON.stringify({level: levelCodes[level], message, attrs}));
+	} finally {
+		sending = false;
+	}
+}
+
+function capture(level, message, attrs) {
+	if (sending) {
+		return;
+	}
+	if (config === undefined) {
+		if (pending.length < maxPending) {
+			pending.push([level, message, attrs]);
+		}
+		return;
+	}
+	if (config === null || level < config.level) {
+		return;
+	}
+	send(level, message, attrs);
+}
+
+function restoreConsole() {
+	Object.keys(originalConsole).forEach(method => {
+		console[method] = originalConsole[method];
+	});
+}
+
+function onError(event) {
+	capture(5, event.message, {
+		type: 'error',
+		url: event.filename,
+		line: event.lineno,
+		column: event.colno,
+		stack: event.error && event.error.stack,
+	});
+}
+
+function onUnhandledRejection(event) {
+	const reason = event.reason;
+	capture(5, 'Unhandled promise rejection: ' + formatValue(reason), {
+		type: 'unhandledrejection',
+		url: window.location.href,
+		stack: reason && reason.stack,
+	});
+}
+
+/**
+ * Captures console output, uncaught errors and unhandled promise rejections and
+ * forwards them to the backend log if capturing is enabled in the application options
+ */
+export function CaptureFrontendLogs() {
+	Object.keys(consoleLevels).forEach(method => {
+		const original = console[method];
+		if (typeof original !== 'function') {
+			return;
+		}
+		originalConsole[method] = original;
+		console[method] = function (...args) {
+			original.apply(console, args);
+			const level = consoleLevels[method];
+			if (config === null || (config && level < config.level)) {
+				return;
+			}
+			const attrs = {
+				type: 'console.' + method,
+				url: window.location.href,
+			};
+			const error = args.find(arg => arg instanceof Error);
+			if (error) {
+				attrs.stack = error.stack;
+			} else if (level >= 4 || method === 'trace') {
+				attrs.stack = new Error().stack;
+			}
+			capture(level, args.map(formatValue).join(' '), attrs);
+		};
+	});
+	window.addEventListener('error', onError);
+	window.addEventListener('unhandledrejection', onUnhandledRejection);
+
+	Call(':wails:FrontendLogCapture').then(result => {
+		config = result;
+		if (!config) {
+			config = null;
+			restoreConsole();
+			window.removeEventListener('error', onError);
+			window.removeEventListener('unhandledrejection', onUnhandledRejection);
+		} else {
+			tokens = config.rateLimit;
+			lastRefill = Date.now();
+			pending.forEach(log => capture(...log));
+		}
+		pending = [];
+	}).catch(() => {
+		config = null;
+		pending = [];
+		restoreConsole();
+	});
+}
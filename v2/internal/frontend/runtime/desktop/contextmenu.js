@@ -48,3 +48,36 @@ export function processDefaultContextMenu(event) {
             event.preventDefault();
     }
 }
+
+/*
+--wails-contextmenu: <id>; opens the native context menu registered in options.App.ContextMenus with the given ID
+--wails-contextmenu-data: <data>; passed to the menu item callbacks as CallbackData.ContextData
+
+Like --default-contextmenu, these rules are inherited so a context menu can be set on a container.
+Returns true if a native context menu was opened.
+*/
+export function processContextMenu(event) {
+    const computedStyle = window.getComputedStyle(event.target);
+    const contextMenuID = unquote(computedStyle.getPropertyValue("--wails-contextmenu"));
+    if (contextMenuID === "" || contextMenuID === "none") {
+        return false;
+    }
+    event.preventDefault();
+    const contextMenu = {
+        id: contextMenuID,
+        data: unquote(computedStyle.getPropertyValue("--wails-contextmenu-data")),
+        x: Math.round(event.clientX),
+        y: Math.round(event.clientY),
+    };
+    window.WailsInvoke("MC" + JSON.stringify(contextMenu));
+    return true;
+}
+
+// unquote trims a CSS property value and removes the quotes of a string value
+function unquote(value) {
+    value = value.trim();
+    if (value.length >= 2 && (value[0] === '"' || value[0] === "'") && value[value.length - 1] === value[0]) {
+        return value.slice(1, -1);
+    }
+    return value;
+}
@@ -0,0 +1,30 @@
+/*
+ _       __      _ __
+| |     / /___ _(_) /____
+| | /| / / __ `/ / / ___/
+| |/ |/ / /_/ / / (__  )
+|__/|__/\__,_/_/_/____/
+The electron alternative for Go
+(c) Lea Anthony 2019-present
+*/
+
+/* jshint esversion: 9 */
+
+import {LogWarning} from './log';
+
+/**
+ * Logs Content-Security-Policy violations with the backend
+ */
+export function ReportCSPViolations() {
+	document.addEventListener('securitypolicyviolation', (event) => {
+		LogWarning(`Content-Security-Policy violation: '${event.effectiveDirective}' blocked ${event.blockedURI || 'inline'}`, {
+			type: 'csp',
+			directive: event.violatedDirective,
+			blockedURI: event.blockedURI,
+			url: event.sourceFile || event.documentURI,
+			line: event.lineNumber,
+			column: event.columnNumber,
+			sample: event.sample,
+		});
+	});
+}
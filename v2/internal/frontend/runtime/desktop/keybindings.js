@@ -0,0 +1,61 @@
+/*
+Key bindings are keyboard shortcuts defined in Go with options.App.KeyBindings or runtime.KeyBindingAdd.
+They are sent to the frontend as they change and are handled before the page's key handlers.
+*/
+
+let keyBindings = [];
+
+export function SetKeyBindings(bindings) {
+    keyBindings = bindings || [];
+}
+
+/*
+Returns true if the key event matched a key binding.
+Key bindings are ignored when an editable element has focus, unless they are enabled in inputs.
+*/
+export function processKeyBinding(event) {
+    if (keyBindings.length === 0 || !event.key) {
+        return false;
+    }
+    const key = event.key.toLowerCase();
+    const codeKey = keyFromCode(event.code);
+    for (const binding of keyBindings) {
+        if (binding.ctrl !== event.ctrlKey || binding.alt !== event.altKey || binding.shift !== event.shiftKey || binding.meta !== event.metaKey) {
+            continue;
+        }
+        if (binding.key !== key && binding.key !== codeKey) {
+            continue;
+        }
+        if (!binding.inInputs && isEditable(event.target)) {
+            return false;
+        }
+        if (binding.preventDefault) {
+            event.preventDefault();
+        }
+        window.WailsInvoke("KP" + binding.id);
+        return true;
+    }
+    return false;
+}
+
+// keyFromCode returns the key of a letter or digit key regardless of the modifiers and keyboard layout, EG: "KeyP" => "p"
+function keyFromCode(code) {
+    if (!code) {
+        return "";
+    }
+    if (code.startsWith("Key")) {
+        return code.slice(3).toLowerCase();
+    }
+    if (code.startsWith("Digit")) {
+        return code.slice(5);
+    }
+    return "";
+}
+
+function isEditable(element) {
+    if (!element) {
+        return false;
+    }
+    const tagName = element.tagName;
+    return tagName === "INPUT" || tagName === "TEXTAREA" || tagName === "SELECT" || element.isContentEditable;
+}
@@ -11,12 +11,21 @@ The electron alternative for Go
 /* jshint esversion: 6 */
 
 /**
- * Sends a log message to the backend with the given level + message
+ * Sends a log message to the backend with the given level + message.
+ * If attributes are given, the message is sent as a structured log message.
  *
  * @param {string} level
  * @param {string} message
+ * @param {Object<string, any>} [attrs]
  */
-function sendLogMessage(level, message) {
+function sendLogMessage(level, message, attrs) {
+
+	// Structured Log Message format:
+	// LJ[JSON encoded level, message and attributes]
+	if (attrs) {
+		window.WailsInvoke('LJ' + JSON.stringify({level, message, attrs}));
+		return;
+	}
 
 	// Log Message format:
 	// l[type][message]
@@ -28,9 +37,10 @@ function sendLogMessage(level, message) {
  *
  * @export
  * @param {string} message
+ * @param {Object<string, any>} [attrs] Optional attributes logged with the message
  */
-export function LogTrace(message) {
-	sendLogMessage('T', message);
+export function LogTrace(message, attrs) {
+	sendLogMessage('T', message, attrs);
 }
 
 /**
@@ -48,9 +58,10 @@ export function LogPrint(message) {
  *
  * @export
  * @param {string} message
+ * @param {Object<string, any>} [attrs] Optional attributes logged with the message
  */
-export function LogDebug(message) {
-	sendLogMessage('D', message);
+export function LogDebug(message, attrs) {
+	sendLogMessage('D', message, attrs);
 }
 
 /**
@@ -58,9 +69,10 @@ export function LogDebug(message) {
  *
  * @export
  * @param {string} message
+ * @param {Object<string, any>} [attrs] Optional attributes logged with the message
  */
-export function LogInfo(message) {
-	sendLogMessage('I', message);
+export function LogInfo(message, attrs) {
+	sendLogMessage('I', message, attrs);
 }
 
 /**
@@ -68,9 +80,10 @@ export function LogInfo(message) {
  *
  * @export
  * @param {string} message
+ * @param {Object<string, any>} [attrs] Optional attributes logged with the message
  */
-export function LogWarning(message) {
-	sendLogMessage('W', message);
+export function LogWarning(message, attrs) {
+	sendLogMessage('W', message, attrs);
 }
 
 /**
@@ -78,9 +91,10 @@ export function LogWarning(message) {
  *
  * @export
  * @param {string} message
+ * @param {Object<string, any>} [attrs] Optional attributes logged with the message
  */
-export function LogError(message) {
-	sendLogMessage('E', message);
+export function LogError(message, attrs) {
+	sendLogMessage('E', message, attrs);
 }
 
 /**
@@ -88,9 +102,10 @@ export function LogError(message) {
  *
  * @export
  * @param {string} message
+ * @param {Object<string, any>} [attrs] Optional attributes logged with the message
  */
-export function LogFatal(message) {
-	sendLogMessage('F', message);
+export function LogFatal(message, attrs) {
+	sendLogMessage('F', message, attrs);
 }
 
 /**
@@ -17,6 +17,10 @@ import * as Screen from "./screen";
 import * as Browser from "./browser";
 import * as Clipboard from "./clipboard";
 import * as ContextMenu from "./contextmenu";
+import * as Menu from "./menu";
+import * as KeyBindings from "./keybindings";
+import {CaptureFrontendLogs} from "./capture";
+import {ReportCSPViolations} from "./csp";
 
 
 export function Quit() {
@@ -35,6 +39,10 @@ export function Environment() {
     return Call(":wails:Environment");
 }
 
+export function BuildInfo() {
+    return Call(":wails:BuildInfo");
+}
+
 // The JS runtime
 window.runtime = {
     ...Log,
@@ -42,12 +50,14 @@ window.runtime = {
     ...Browser,
     ...Screen,
     ...Clipboard,
+    ...Menu,
     EventsOn,
     EventsOnce,
     EventsOnMultiple,
     EventsEmit,
     EventsOff,
     Environment,
+    BuildInfo,
     Show,
     Hide,
     Quit
@@ -84,6 +94,14 @@ if (!DEBUG) {
     delete window.wailsbindings;
 }
 
+// Forward console output and errors to the backend log if enabled
+CaptureFrontendLogs();
+
+// Log Content-Security-Policy violations in debug builds
+if (DEBUG) {
+    ReportCSPViolations();
+}
+
 let dragTest = function (e) {
     var val = window.getComputedStyle(e.target).getPropertyValue(window.wails.flags.cssDragProperty);
     if (val) {
@@ -188,6 +206,9 @@ window.addEventListener('mousemove', function (e) {
 
 // Setup context menu hook
 window.addEventListener('contextmenu', function (e) {
+    // native context menus are opened in all builds
+    if (ContextMenu.processContextMenu(e)) return;
+
     // always show the contextmenu in debug & dev
     if (DEBUG) return;
 
@@ -198,4 +219,11 @@ window.addEventListener('contextmenu', function (e) {
     }
 });
 
-window.WailsInvoke("runtime:ready");
\ No newline at end of file
+// Handle the key bindings before the page's key handlers
+window.addEventListener('keydown', KeyBindings.processKeyBinding, true);
+EventsOn("wails:keybindings", KeyBindings.SetKeyBindings);
+
+window.WailsInvoke("runtime:ready");
+
+// Request the key bindings
+window.WailsInvoke("KL");
\ No newline at end of file
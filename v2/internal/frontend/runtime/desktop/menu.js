@@ -0,0 +1,34 @@
+/*
+ _       __      _ __
+| |     / /___ _(_) /____
+| | /| / / __ `/ / / ___/
+| |/ |/ / /_/ / / (__  )
+|__/|__/\__,_/_/_/____/
+The electron alternative for Go
+(c) Lea Anthony 2019-present
+*/
+
+/* jshint esversion: 9 */
+
+import {Call} from "./calls";
+
+/**
+ * Gets the visible items of the application menu, EG: to draw a custom title bar.
+ * The "menu:updated" event is emitted with the items when the application menu changes.
+ *
+ * @export
+ * @return {Promise<MenuItem[]>} The items of the application menu
+ */
+export function MenuGetApplicationMenu() {
+    return Call(":wails:MenuGetApplicationMenu");
+}
+
+/**
+ * Clicks the application menu item with the given ID
+ *
+ * @export
+ * @param {string} id
+ */
+export function MenuClick(id) {
+    window.WailsInvoke("MA" + id);
+}
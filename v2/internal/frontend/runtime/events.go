@@ -5,12 +5,18 @@ import (
 
 	"github.com/samber/lo"
 	"github.com/wailsapp/wails/v2/internal/frontend"
+	"github.com/wailsapp/wails/v2/pkg/crashreport"
 )
 
 type Logger interface {
 	Trace(format string, v ...interface{})
 }
 
+// CrashReporter reports panics in event listeners
+type CrashReporter interface {
+	Recover(source string)
+}
+
 // eventListener holds a callback function which is invoked when
 // the event listened for is emitted. It has a counter which indicates
 // how the total number of events it is interested in. A value of zero
@@ -29,6 +35,13 @@ type Events struct {
 	// Go event listeners
 	listeners  map[string][]*eventListener
 	notifyLock sync.RWMutex
+
+	crashReporter CrashReporter
+}
+
+// SetCrashReporter reports panics in event listeners using the given CrashReporter
+func (e *Events) SetCrashReporter(crashReporter CrashReporter) {
+	e.crashReporter = crashReporter
 }
 
 func (e *Events) Notify(sender frontend.Frontend, name string, data ...interface{}) {
@@ -114,6 +127,14 @@ func (e *Events) unRegisterListener(eventName string) {
 	e.notifyLock.Unlock()
 }
 
+// callListener calls the callback of a listener, reporting it if it panics
+func (e *Events) callListener(callback func(...interface{}), data []interface{}) {
+	if e.crashReporter != nil {
+		defer e.crashReporter.Recover(crashreport.SourceEventListener)
+	}
+	callback(data...)
+}
+
 // Notify backend for the given event name
 func (e *Events) notifyBackend(eventName string, data ...interface{}) {
 	e.notifyLock.Lock()
@@ -134,7 +155,7 @@ func (e *Events) notifyBackend(eventName string, data ...interface{}) {
 		if listener.counter > 0 {
 			listener.counter--
 		}
-		go listener.callback(data...)
+		go e.callListener(listener.callback, data)
 
 		if listener.counter == 0 {
 			listener.delete = true
@@ -25,6 +25,23 @@ export interface Screen {
     height : number
 }
 
+// An item of the application menu
+export interface MenuItem {
+    ID: string;
+    Label?: string;
+    // The accelerator formatted for the platform, EG: "Ctrl+Shift+S"
+    Accelerator?: string;
+    Type: "Text" | "Separator" | "Submenu" | "Checkbox" | "Radio";
+    Disabled?: boolean;
+    Checked?: boolean;
+    // PNG images encoded as base64
+    Icon?: string;
+    IconDark?: string;
+    Tooltip?: string;
+    Sublabel?: string;
+    SubMenu?: MenuItem[];
+}
+
 // Environment information such as platform, buildtype, ...
 export interface EnvironmentInfo {
     buildType: string;
@@ -32,6 +49,16 @@ export interface EnvironmentInfo {
     arch: string;
 }
 
+// Build information embedded by the Wails CLI
+export interface BuildInfo {
+    appVersion: string;
+    gitCommit: string;
+    buildTime: string;
+    wailsVersion: string;
+    tags: string[];
+    reproducible: boolean;
+}
+
 // [EventsEmit](https://wails.io/docs/reference/runtime/events#eventsemit)
 // emits the given event. Optional data may be passed with the event.
 // This will trigger any event listeners.
@@ -62,28 +89,28 @@ export function LogPrint(message: string): void;
 
 // [LogTrace](https://wails.io/docs/reference/runtime/log#logtrace)
 // logs the given message at the `trace` log level.
-export function LogTrace(message: string): void;
+export function LogTrace(message: string, attrs?: Record<string, any>): void;
 
 // [LogDebug](https://wails.io/docs/reference/runtime/log#logdebug)
 // logs the given message at the `debug` log level.
-export function LogDebug(message: string): void;
+export function LogDebug(message: string, attrs?: Record<string, any>): void;
 
 // [LogError](https://wails.io/docs/reference/runtime/log#logerror)
 // logs the given message at the `error` log level.
-export function LogError(message: string): void;
+export function LogError(message: string, attrs?: Record<string, any>): void;
 
 // [LogFatal](https://wails.io/docs/reference/runtime/log#logfatal)
 // logs the given message at the `fatal` log level.
 // The application will quit after calling this method.
-export function LogFatal(message: string): void;
+export function LogFatal(message: string, attrs?: Record<string, any>): void;
 
 // [LogInfo](https://wails.io/docs/reference/runtime/log#loginfo)
 // logs the given message at the `info` log level.
-export function LogInfo(message: string): void;
+export function LogInfo(message: string, attrs?: Record<string, any>): void;
 
 // [LogWarning](https://wails.io/docs/reference/runtime/log#logwarning)
 // logs the given message at the `warning` log level.
-export function LogWarning(message: string): void;
+export function LogWarning(message: string, attrs?: Record<string, any>): void;
 
 // [WindowReload](https://wails.io/docs/reference/runtime/window#windowreload)
 // Forces a reload by the main application as well as connected browsers.
@@ -214,6 +241,10 @@ export function BrowserOpenURL(url: string): void;
 // Returns information about the environment
 export function Environment(): Promise<EnvironmentInfo>;
 
+// [BuildInfo](https://wails.io/docs/reference/runtime/intro#buildinfo)
+// Returns the build information embedded into the application by the Wails CLI
+export function BuildInfo(): Promise<BuildInfo>;
+
 // [Quit](https://wails.io/docs/reference/runtime/intro#quit)
 // Quits the application.
 export function Quit(): void;
@@ -233,3 +264,11 @@ export function ClipboardGetText(): Promise<string>;
 // [ClipboardSetText](https://wails.io/docs/reference/runtime/clipboard#clipboardsettext)
 // Sets a text on the clipboard
 export function ClipboardSetText(text: string): Promise<boolean>;
+
+// [MenuGetApplicationMenu](https://wails.io/docs/reference/runtime/menu#menugetapplicationmenu)
+// Returns the visible items of the application menu, EG: to draw a custom title bar
+export function MenuGetApplicationMenu(): Promise<MenuItem[]>;
+
+// [MenuClick](https://wails.io/docs/reference/runtime/menu#menuclick)
+// Clicks the application menu item with the given ID
+export function MenuClick(id: string): void;
@@ -12,28 +12,28 @@ export function LogPrint(message) {
     window.runtime.LogPrint(message);
 }
 
-export function LogTrace(message) {
-    window.runtime.LogTrace(message);
+export function LogTrace(message, attrs) {
+    window.runtime.LogTrace(message, attrs);
 }
 
-export function LogDebug(message) {
-    window.runtime.LogDebug(message);
+export function LogDebug(message, attrs) {
+    window.runtime.LogDebug(message, attrs);
 }
 
-export function LogInfo(message) {
-    window.runtime.LogInfo(message);
+export function LogInfo(message, attrs) {
+    window.runtime.LogInfo(message, attrs);
 }
 
-export function LogWarning(message) {
-    window.runtime.LogWarning(message);
+export function LogWarning(message, attrs) {
+    window.runtime.LogWarning(message, attrs);
 }
 
-export function LogError(message) {
-    window.runtime.LogError(message);
+export function LogError(message, attrs) {
+    window.runtime.LogError(message, attrs);
 }
 
-export function LogFatal(message) {
-    window.runtime.LogFatal(message);
+export function LogFatal(message, attrs) {
+    window.runtime.LogFatal(message, attrs);
 }
 
 export function EventsOnMultiple(eventName, callback, maxCallbacks) {
@@ -181,6 +181,10 @@ export function Environment() {
     return window.runtime.Environment();
 }
 
+export function BuildInfo() {
+    return window.runtime.BuildInfo();
+}
+
 export function Quit() {
     window.runtime.Quit();
 }
@@ -199,4 +203,12 @@ export function ClipboardGetText() {
 
 export function ClipboardSetText(text) {
     return window.runtime.ClipboardSetText(text);
+}
+
+export function MenuGetApplicationMenu() {
+    return window.runtime.MenuGetApplicationMenu();
+}
+
+export function MenuClick(id) {
+    window.runtime.MenuClick(id);
 }
\ No newline at end of file
@@ -0,0 +1,157 @@
+package frontend
+
+import (
+	"sync"
+	"time"
+)
+
+// WindowEventKind is the name of an event emitted when the main window changes
+type WindowEventKind string
+
+const (
+	// WindowEventResize is emitted with a WindowSize when the window has been resized
+	WindowEventResize WindowEventKind = "wails:window:resize"
+	// WindowEventMove is emitted with a WindowPosition when the window has been moved
+	WindowEventMove WindowEventKind = "wails:window:move"
+	// WindowEventFocus is emitted when the window gains focus
+	WindowEventFocus WindowEventKind = "wails:window:focus"
+	// WindowEventBlur is emitted when the window loses focus
+	WindowEventBlur WindowEventKind = "wails:window:blur"
+	// WindowEventMinimise is emitted when the window has been minimised
+	WindowEventMinimise WindowEventKind = "wails:window:minimise"
+	// WindowEventMaximise is emitted when the window has been maximised
+	WindowEventMaximise WindowEventKind = "wails:window:maximise"
+	// WindowEventFullscreen is emitted when the window has entered fullscreen
+	WindowEventFullscreen WindowEventKind = "wails:window:fullscreen"
+	// WindowEventRestore is emitted when the window returns to its normal state
+	// after being minimised, maximised or fullscreen
+	WindowEventRestore WindowEventKind = "wails:window:restore"
+)
+
+// WindowSize is the payload of WindowEventResize events
+type WindowSize struct {
+	Width  int `json:"width"`
+	Height int `json:"height"`
+}
+
+// WindowPosition is the payload of WindowEventMove events
+type WindowPosition struct {
+	X int `json:"x"`
+	Y int `json:"y"`
+}
+
+// windowEventDelay is the time without changes before a resize or move event is emitted
+var windowEventDelay = 100 * time.Millisecond
+
+// windowState is the state of the window that has a window event
+type windowState int
+
+const (
+	windowNormal windowState = iota
+	windowMinimised
+	windowMaximised
+	windowFullscreen
+)
+
+var windowStateEvents = map[windowState]WindowEventKind{
+	windowNormal:     WindowEventRestore,
+	windowMinimised:  WindowEventMinimise,
+	windowMaximised:  WindowEventMaximise,
+	windowFullscreen: WindowEventFullscreen,
+}
+
+// WindowEvents emits the window events of a desktop frontend. The frontend reports every change
+// and the events are only emitted when the value has changed. Resize and move events are debounced,
+// so only the final size and position of a drag are emitted.
+type WindowEvents struct {
+	events Events
+
+	lock        sync.Mutex
+	size        WindowSize
+	position    WindowPosition
+	focused     bool
+	state       windowState
+	resizeTimer *time.Timer
+	moveTimer   *time.Timer
+}
+
+func NewWindowEvents(events Events) *WindowEvents {
+	return &WindowEvents{
+		events: events,
+	}
+}
+
+// Resized reports the size of the window
+func (w *WindowEvents) Resized(width int, height int) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	size := WindowSize{Width: width, Height: height}
+	if w.resizeTimer != nil {
+		w.resizeTimer.Stop()
+	}
+	w.resizeTimer = time.AfterFunc(windowEventDelay, func() {
+		w.lock.Lock()
+		changed := size != w.size
+		w.size = size
+		w.lock.Unlock()
+		if changed {
+			w.events.Emit(string(WindowEventResize), size)
+		}
+	})
+}
+
+// Moved reports the position of the window
+func (w *WindowEvents) Moved(x int, y int) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	position := WindowPosition{X: x, Y: y}
+	if w.moveTimer != nil {
+		w.moveTimer.Stop()
+	}
+	w.moveTimer = time.AfterFunc(windowEventDelay, func() {
+		w.lock.Lock()
+		changed := position != w.position
+		w.position = position
+		w.lock.Unlock()
+		if changed {
+			w.events.Emit(string(WindowEventMove), position)
+		}
+	})
+}
+
+// FocusChanged reports whether the window has focus
+func (w *WindowEvents) FocusChanged(focused bool) {
+	w.lock.Lock()
+	changed := focused != w.focused
+	w.focused = focused
+	w.lock.Unlock()
+	if !changed {
+		return
+	}
+	if focused {
+		w.events.Emit(string(WindowEventFocus))
+	} else {
+		w.events.Emit(string(WindowEventBlur))
+	}
+}
+
+// StateChanged reports whether the window is minimised, maximised or fullscreen
+func (w *WindowEvents) StateChanged(minimised bool, maximised bool, fullscreen bool) {
+	state := windowNormal
+	switch {
+	case minimised:
+		state = windowMinimised
+	case fullscreen:
+		state = windowFullscreen
+	case maximised:
+		state = windowMaximised
+	}
+
+	w.lock.Lock()
+	changed := state != w.state
+	w.state = state
+	w.lock.Unlock()
+	if changed {
+		w.events.Emit(string(windowStateEvents[state]))
+	}
+}
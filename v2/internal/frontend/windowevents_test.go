@@ -0,0 +1,77 @@
+package frontend
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/matryer/is"
+)
+
+type emitted struct {
+	name string
+	data []interface{}
+}
+
+type testEvents struct {
+	Events
+	lock    sync.Mutex
+	emitted []emitted
+}
+
+func (t *testEvents) Emit(eventName string, data ...interface{}) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	t.emitted = append(t.emitted, emitted{name: eventName, data: data})
+}
+
+func (t *testEvents) result() []emitted {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	result := t.emitted
+	t.emitted = nil
+	return result
+}
+
+func TestWindowEvents(t *testing.T) {
+	defer func(delay time.Duration) { windowEventDelay = delay }(windowEventDelay)
+	windowEventDelay = 10 * time.Millisecond
+
+	is2 := is.New(t)
+	events := &testEvents{}
+	windowEvents := NewWindowEvents(events)
+
+	// Only the final size and position are emitted
+	windowEvents.Resized(800, 600)
+	windowEvents.Resized(810, 610)
+	time.Sleep(5 * windowEventDelay)
+	is2.Equal(events.result(), []emitted{
+		{name: "wails:window:resize", data: []interface{}{WindowSize{Width: 810, Height: 610}}},
+	})
+	windowEvents.Moved(10, 20)
+	windowEvents.Moved(30, 40)
+	time.Sleep(5 * windowEventDelay)
+	is2.Equal(events.result(), []emitted{
+		{name: "wails:window:move", data: []interface{}{WindowPosition{X: 30, Y: 40}}},
+	})
+
+	// Unchanged values are not emitted
+	windowEvents.Resized(810, 610)
+	windowEvents.FocusChanged(true)
+	windowEvents.FocusChanged(true)
+	windowEvents.FocusChanged(false)
+	windowEvents.StateChanged(false, true, false)
+	windowEvents.StateChanged(true, true, false)
+	windowEvents.StateChanged(false, true, true)
+	windowEvents.StateChanged(false, false, false)
+	windowEvents.StateChanged(false, false, false)
+	time.Sleep(5 * windowEventDelay)
+	is2.Equal(events.result(), []emitted{
+		{name: "wails:window:focus"},
+		{name: "wails:window:blur"},
+		{name: "wails:window:maximise"},
+		{name: "wails:window:minimise"},
+		{name: "wails:window:fullscreen"},
+		{name: "wails:window:restore"},
+	})
+}
@@ -92,6 +92,17 @@ func FileExists(path string) bool {
 	return fi.Mode().IsRegular()
 }
 
+// ExecutableName returns the name of the running executable without its extension,
+// or "wails" if it cannot be determined
+func ExecutableName() string {
+	executable, err := os.Executable()
+	if err != nil {
+		return "wails"
+	}
+	name := filepath.Base(executable)
+	return strings.TrimSuffix(name, filepath.Ext(name))
+}
+
 // RelativePath returns a qualified path created by joining the
 // directory of the calling file and the given relative path.
 //
@@ -0,0 +1,177 @@
+package keybindings
+
+import (
+	"context"
+	"fmt"
+	goruntime "runtime"
+	"sort"
+	"sync"
+
+	"github.com/wailsapp/wails/v2/internal/frontend"
+	"github.com/wailsapp/wails/v2/pkg/menu/keys"
+)
+
+// eventName is the event used to send the key bindings to the frontend
+const eventName = "wails:keybindings"
+
+// KeyBinding is a keyboard shortcut that is handled by the runtime in the WebView
+type KeyBinding struct {
+	// Accelerator in the keys.Parse syntax, EG: "CmdOrCtrl+Shift+P"
+	Accelerator string
+	// Callback is called with the application context when the keys are pressed
+	Callback func(ctx context.Context)
+	// PreventDefault prevents the default action of the key event, EG: the print dialog for "Ctrl+P"
+	PreventDefault bool
+	// InInputs calls the callback when an input, textarea, select or editable element has focus.
+	// By default, the key binding is ignored so the keys can be typed.
+	InInputs bool
+}
+
+// binding is a KeyBinding as sent to the frontend
+type binding struct {
+	ID             string `json:"id"`
+	Key            string `json:"key"`
+	Ctrl           bool   `json:"ctrl"`
+	Alt            bool   `json:"alt"`
+	Shift          bool   `json:"shift"`
+	Meta           bool   `json:"meta"`
+	PreventDefault bool   `json:"preventDefault"`
+	InInputs       bool   `json:"inInputs"`
+}
+
+// domKeys are the named keys that have a different name in KeyboardEvent.key
+var domKeys = map[string]string{
+	"return":    "enter",
+	"left":      "arrowleft",
+	"right":     "arrowright",
+	"up":        "arrowup",
+	"down":      "arrowdown",
+	"space":     " ",
+	"page up":   "pageup",
+	"page down": "pagedown",
+}
+
+type Manager struct {
+	lock     sync.RWMutex
+	ctx      context.Context
+	events   frontend.Events
+	bindings map[string]*KeyBinding
+	platform string
+}
+
+func NewManager(events frontend.Events) *Manager {
+	return &Manager{
+		events:   events,
+		bindings: make(map[string]*KeyBinding),
+		platform: goruntime.GOOS,
+	}
+}
+
+// SetContext sets the application context passed to the callbacks
+func (m *Manager) SetContext(ctx context.Context) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.ctx = ctx
+}
+
+// Add adds the key binding, replacing any key binding for the same keys
+func (m *Manager) Add(keyBinding *KeyBinding) error {
+	if keyBinding == nil || keyBinding.Callback == nil {
+		return fmt.Errorf("no callback given for key binding")
+	}
+	id, err := m.id(keyBinding.Accelerator)
+	if err != nil {
+		return err
+	}
+	m.lock.Lock()
+	m.bindings[id] = keyBinding
+	m.lock.Unlock()
+	m.Notify()
+	return nil
+}
+
+// Remove removes the key binding for the accelerator
+func (m *Manager) Remove(accelerator string) error {
+	id, err := m.id(accelerator)
+	if err != nil {
+		return err
+	}
+	m.lock.Lock()
+	_, exists := m.bindings[id]
+	delete(m.bindings, id)
+	m.lock.Unlock()
+	if !exists {
+		return fmt.Errorf("no key binding for '%s'", accelerator)
+	}
+	m.Notify()
+	return nil
+}
+
+// Notify sends the key bindings to the frontends
+func (m *Manager) Notify() {
+	m.lock.RLock()
+	result := make([]binding, 0, len(m.bindings))
+	for id, keyBinding := range m.bindings {
+		// The accelerator has been validated when the key binding was added
+		accelerator, _ := keys.Parse(keyBinding.Accelerator)
+		result = append(result, m.binding(id, accelerator, keyBinding))
+	}
+	m.lock.RUnlock()
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].ID < result[j].ID
+	})
+	m.events.Emit(eventName, result)
+}
+
+// Trigger calls the callback of the key binding with the given ID
+func (m *Manager) Trigger(id string) error {
+	m.lock.RLock()
+	keyBinding := m.bindings[id]
+	ctx := m.ctx
+	m.lock.RUnlock()
+	if keyBinding == nil {
+		return fmt.Errorf("no key binding for '%s'", id)
+	}
+	go keyBinding.Callback(ctx)
+	return nil
+}
+
+// id returns the ID of the accelerator, which is the same for all accelerators
+// that are the same keys on this platform, EG: "Ctrl+Shift+P" and "Shift+CmdOrCtrl+P" on Linux.
+func (m *Manager) id(accelerator string) (string, error) {
+	parsed, err := keys.Parse(accelerator)
+	if err != nil {
+		return "", fmt.Errorf("invalid key binding '%s': %w", accelerator, err)
+	}
+	return keys.Stringify(keys.Resolve(parsed, m.platform), m.platform), nil
+}
+
+// binding returns the key binding as sent to the frontend
+func (m *Manager) binding(id string, accelerator *keys.Accelerator, keyBinding *KeyBinding) binding {
+	result := binding{
+		ID:             id,
+		Key:            accelerator.Key,
+		PreventDefault: keyBinding.PreventDefault,
+		InInputs:       keyBinding.InInputs,
+	}
+	if domKey, ok := domKeys[accelerator.Key]; ok {
+		result.Key = domKey
+	}
+	for _, modifier := range accelerator.Modifiers {
+		switch modifier {
+		case keys.CmdOrCtrlKey:
+			if m.platform == "darwin" {
+				result.Meta = true
+			} else {
+				result.Ctrl = true
+			}
+		case keys.ControlKey:
+			result.Ctrl = true
+		case keys.OptionOrAltKey:
+			result.Alt = true
+		case keys.ShiftKey:
+			result.Shift = true
+		}
+	}
+	return result
+}
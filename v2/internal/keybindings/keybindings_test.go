@@ -0,0 +1,71 @@
+package keybindings
+
+import (
+	"context"
+	"testing"
+
+	"github.com/matryer/is"
+	"github.com/wailsapp/wails/v2/internal/frontend"
+)
+
+type testEvents struct {
+	frontend.Events
+	bindings []binding
+}
+
+func (e *testEvents) Emit(eventName string, data ...interface{}) {
+	if eventName == "wails:keybindings" {
+		e.bindings = data[0].([]binding)
+	}
+}
+
+func TestManager(t *testing.T) {
+	is2 := is.New(t)
+
+	events := &testEvents{}
+	manager := NewManager(events)
+	manager.platform = "linux"
+
+	called := make(chan context.Context, 1)
+	ctx := context.WithValue(context.Background(), "test", true)
+	manager.SetContext(ctx)
+
+	err := manager.Add(&KeyBinding{Accelerator: "Shift+CmdOrCtrl+p", Callback: func(ctx context.Context) { called <- ctx }, PreventDefault: true})
+	is2.NoErr(err)
+	err = manager.Add(&KeyBinding{Accelerator: "page up", Callback: func(ctx context.Context) {}, InInputs: true})
+	is2.NoErr(err)
+	is2.Equal(events.bindings, []binding{
+		{ID: "Ctrl+Shift+P", Key: "p", Ctrl: true, Shift: true, PreventDefault: true},
+		{ID: "PAGE UP", Key: "pageup", InInputs: true},
+	})
+
+	// The same keys replace the existing key binding
+	err = manager.Add(&KeyBinding{Accelerator: "Ctrl+Shift+P", Callback: func(ctx context.Context) { called <- ctx }})
+	is2.NoErr(err)
+	is2.Equal(len(events.bindings), 2)
+	is2.Equal(events.bindings[0].PreventDefault, false)
+
+	is2.NoErr(manager.Trigger("Ctrl+Shift+P"))
+	is2.Equal(<-called, ctx)
+	is2.True(manager.Trigger("Ctrl+Q") != nil)
+
+	is2.NoErr(manager.Remove("page up"))
+	is2.Equal(len(events.bindings), 1)
+	is2.True(manager.Remove("page up") != nil)
+
+	is2.True(manager.Add(&KeyBinding{Accelerator: "Ctrl+Nope", Callback: func(ctx context.Context) {}}) != nil)
+	is2.True(manager.Add(&KeyBinding{Accelerator: "Ctrl+P"}) != nil)
+}
+
+func TestManagerMac(t *testing.T) {
+	is2 := is.New(t)
+
+	events := &testEvents{}
+	manager := NewManager(events)
+	manager.platform = "darwin"
+
+	is2.NoErr(manager.Add(&KeyBinding{Accelerator: "CmdOrCtrl+Ctrl+OptionOrAlt+space", Callback: func(ctx context.Context) {}}))
+	is2.Equal(events.bindings, []binding{
+		{ID: "Cmd+Ctrl+Option+SPACE", Key: " ", Ctrl: true, Alt: true, Meta: true},
+	})
+}
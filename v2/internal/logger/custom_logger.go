@@ -29,6 +29,9 @@ type CustomLogger interface {
 
 	// Fatal level logging. Works like Sprintf.
 	Fatal(format string, args ...interface{})
+
+	// Structured returns a structured logger that adds the name as the "component" attribute
+	Structured() *Structured
 }
 
 // customLogger is a utlility to log messages to a number of destinations
@@ -94,3 +97,8 @@ func (l *customLogger) Fatal(format string, args ...interface{}) {
 	format = fmt.Sprintf("%s | %s", l.name, format)
 	l.logger.Fatal(format, args...)
 }
+
+// Structured returns a structured logger that adds the name as the "component" attribute
+func (l *customLogger) Structured() *Structured {
+	return l.logger.Structured().With("component", l.name)
+}
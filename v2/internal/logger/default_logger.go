@@ -3,6 +3,8 @@ package logger
 import (
 	"fmt"
 	"os"
+	"sync"
+	"time"
 
 	"github.com/wailsapp/wails/v2/pkg/logger"
 )
@@ -10,11 +12,21 @@ import (
 // LogLevel is an alias for the public LogLevel
 type LogLevel = logger.LogLevel
 
+// FATAL is the level of Fatal logs. It can't be used as the log level
+const FATAL LogLevel = logger.ERROR + 1
+
 // Logger is a utlility to log messages to a number of destinations
 type Logger struct {
 	output         logger.Logger
+	handler        logger.SlogHandler // Receives all logs if set. Requires Go 1.21
 	logLevel       LogLevel
 	showLevelInLog bool
+
+	// Ring buffer of recent logs, used for crash reports
+	recent      []string
+	recentNext  int
+	recentLock  sync.Mutex
+	recentLimit int
 }
 
 // New creates a new Logger. You may pass in a number of `io.Writer`s that
@@ -51,12 +63,12 @@ func (l *Logger) SetLogLevel(level LogLevel) {
 // Writeln writes directly to the output with no log level
 // Appends a carriage return to the message
 func (l *Logger) Writeln(message string) {
-	l.output.Print(message)
+	l.log(logger.INFO, l.output.Print, message)
 }
 
 // Write writes directly to the output with no log level
 func (l *Logger) Write(message string) {
-	l.output.Print(message)
+	l.log(logger.INFO, l.output.Print, message)
 }
 
 // Print writes directly to the output with no log level
@@ -68,21 +80,21 @@ func (l *Logger) Print(message string) {
 // Trace level logging. Works like Sprintf.
 func (l *Logger) Trace(format string, args ...interface{}) {
 	if l.logLevel <= logger.TRACE {
-		l.output.Trace(fmt.Sprintf(format, args...))
+		l.log(logger.TRACE, l.output.Trace, fmt.Sprintf(format, args...))
 	}
 }
 
 // Debug level logging. Works like Sprintf.
 func (l *Logger) Debug(format string, args ...interface{}) {
 	if l.logLevel <= logger.DEBUG {
-		l.output.Debug(fmt.Sprintf(format, args...))
+		l.log(logger.DEBUG, l.output.Debug, fmt.Sprintf(format, args...))
 	}
 }
 
 // Info level logging. Works like Sprintf.
 func (l *Logger) Info(format string, args ...interface{}) {
 	if l.logLevel <= logger.INFO {
-		l.output.Info(fmt.Sprintf(format, args...))
+		l.log(logger.INFO, l.output.Info, fmt.Sprintf(format, args...))
 	}
 
 }
@@ -90,20 +102,91 @@ func (l *Logger) Info(format string, args ...interface{}) {
 // Warning level logging. Works like Sprintf.
 func (l *Logger) Warning(format string, args ...interface{}) {
 	if l.logLevel <= logger.WARNING {
-		l.output.Warning(fmt.Sprintf(format, args...))
+		l.log(logger.WARNING, l.output.Warning, fmt.Sprintf(format, args...))
 	}
 }
 
 // Error level logging. Works like Sprintf.
 func (l *Logger) Error(format string, args ...interface{}) {
 	if l.logLevel <= logger.ERROR {
-		l.output.Error(fmt.Sprintf(format, args...))
+		l.log(logger.ERROR, l.output.Error, fmt.Sprintf(format, args...))
 	}
 
 }
 
 // Fatal level logging. Works like Sprintf.
 func (l *Logger) Fatal(format string, args ...interface{}) {
-	l.output.Fatal(fmt.Sprintf(format, args...))
+	l.log(FATAL, l.output.Fatal, fmt.Sprintf(format, args...))
+	l.Flush()
 	os.Exit(1)
 }
+
+// Flush writes any logs buffered by the output logger
+func (l *Logger) Flush() {
+	if flusher, ok := l.output.(logger.Flusher); ok {
+		_ = flusher.Flush()
+	}
+}
+
+// KeepRecent keeps the given number of the most recent logs in memory. See Recent.
+func (l *Logger) KeepRecent(count int) {
+	l.recentLock.Lock()
+	defer l.recentLock.Unlock()
+	l.recentLimit = count
+	l.recent = make([]string, 0, count)
+	l.recentNext = 0
+}
+
+// Recent returns the most recent logs, oldest first
+func (l *Logger) Recent() []string {
+	l.recentLock.Lock()
+	defer l.recentLock.Unlock()
+	result := make([]string, 0, len(l.recent))
+	result = append(result, l.recent[l.recentNext:]...)
+	return append(result, l.recent[:l.recentNext]...)
+}
+
+func (l *Logger) keepsRecent() bool {
+	l.recentLock.Lock()
+	defer l.recentLock.Unlock()
+	return l.recentLimit > 0
+}
+
+func (l *Logger) remember(level LogLevel, message string) {
+	l.recentLock.Lock()
+	defer l.recentLock.Unlock()
+	if l.recentLimit == 0 {
+		return
+	}
+	entry := time.Now().Format("2006-01-02 15:04:05.000") + " | " + levelName(level) + " | " + message
+	if len(l.recent) < l.recentLimit {
+		l.recent = append(l.recent, entry)
+		return
+	}
+	l.recent[l.recentNext] = entry
+	l.recentNext = (l.recentNext + 1) % l.recentLimit
+}
+
+// log writes the message to the output, or to the handler if one was set
+func (l *Logger) log(level LogLevel, output func(message string), message string) {
+	l.remember(level, message)
+	if !l.handle(level, message) {
+		output(message)
+	}
+}
+
+func levelName(level LogLevel) string {
+	switch level {
+	case logger.TRACE:
+		return "TRACE"
+	case logger.DEBUG:
+		return "DEBUG"
+	case logger.INFO:
+		return "INFO"
+	case logger.WARNING:
+		return "WARN"
+	case logger.ERROR:
+		return "ERROR"
+	}
+	return "FATAL"
+}
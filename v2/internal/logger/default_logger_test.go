@@ -0,0 +1,100 @@
+//go:build go1.21
+// +build go1.21
+
+package logger
+
+import (
+	"bytes"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/matryer/is"
+	"github.com/wailsapp/wails/v2/pkg/logger"
+)
+
+// recordingLogger records the messages logged to it, prefixed with their level
+type recordingLogger struct {
+	messages []string
+}
+
+func (l *recordingLogger) Print(message string) { l.messages = append(l.messages, "PRINT "+message) }
+func (l *recordingLogger) Trace(message string) { l.messages = append(l.messages, "TRACE "+message) }
+func (l *recordingLogger) Debug(message string) { l.messages = append(l.messages, "DEBUG "+message) }
+func (l *recordingLogger) Info(message string)  { l.messages = append(l.messages, "INFO "+message) }
+func (l *recordingLogger) Warning(message string) {
+	l.messages = append(l.messages, "WARNING "+message)
+}
+func (l *recordingLogger) Error(message string) { l.messages = append(l.messages, "ERROR "+message) }
+func (l *recordingLogger) Fatal(message string) { l.messages = append(l.messages, "FATAL "+message) }
+
+func TestLogger_SlogHonoursLogLevel(t *testing.T) {
+	is2 := is.New(t)
+
+	output := &recordingLogger{}
+	log := New(output)
+	log.SetLogLevel(logger.WARNING)
+
+	structured := log.Slog()
+	structured.Debug("debug")
+	structured.Info("info")
+	structured.Warn("warn", "id", 1)
+	structured.Error("error")
+	// The level is checked when logging, not when the structured logger is created
+	log.SetLogLevel(logger.DEBUG)
+	structured.Debug("debug")
+
+	is2.Equal(output.messages, []string{"WARNING warn id=1", "ERROR error", "DEBUG debug"})
+}
+
+func TestLogger_SlogWithAttrsAndGroups(t *testing.T) {
+	is2 := is.New(t)
+
+	output := &recordingLogger{}
+	log := New(output)
+	log.SetLogLevel(logger.INFO)
+
+	structured := log.Slog().With("component", "dispatcher").WithGroup("call")
+	structured.Debug("ignored", "method", "Greet")
+	structured.Info("called", "method", "Greet")
+
+	is2.Equal(output.messages, []string{"INFO called component=dispatcher call.method=Greet"})
+}
+
+func TestLogger_SetHandler(t *testing.T) {
+	is2 := is.New(t)
+
+	var buffer bytes.Buffer
+	log := New(&recordingLogger{})
+	log.SetLogLevel(logger.INFO)
+	log.SetHandler(slog.NewTextHandler(&buffer, &slog.HandlerOptions{Level: logger.SlogLevelTrace}))
+
+	log.Slog().Debug("ignored")
+	log.Slog().Info("structured", "id", 1)
+	log.Info("formatted %d", 2)
+
+	lines := strings.Split(strings.TrimSpace(buffer.String()), "\n")
+	is2.Equal(len(lines), 2)
+	is2.True(strings.Contains(lines[0], `level=INFO msg=structured id=1`))
+	is2.True(strings.Contains(lines[1], `level=INFO msg="formatted 2"`))
+}
+
+func TestLogger_SlogRemembersRecentLogs(t *testing.T) {
+	is2 := is.New(t)
+
+	log := New(&recordingLogger{})
+	log.KeepRecent(2)
+	log.Slog().Info("first")
+	log.Slog().Warn("second", "id", 2)
+	log.Slog().Error("third")
+
+	recent := log.Recent()
+	is2.Equal(len(recent), 2)
+	is2.True(strings.HasSuffix(recent[0], " | WARN | second id=2"))
+	is2.True(strings.HasSuffix(recent[1], " | ERROR | third"))
+}
+
+func TestLogger_ZeroLoggerSlog(t *testing.T) {
+	var log Logger
+	log.Slog().Error("discarded")
+}
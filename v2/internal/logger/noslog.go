@@ -0,0 +1,87 @@
+//go:build !go1.21
+// +build !go1.21
+
+package logger
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/wailsapp/wails/v2/pkg/logger"
+)
+
+// SetHandler has no effect as log/slog handlers require Go 1.21
+func (l *Logger) SetHandler(_ logger.SlogHandler) {}
+
+// handle returns false as log/slog handlers require Go 1.21
+func (l *Logger) handle(_ LogLevel, _ string) bool {
+	return false
+}
+
+// Structured logs messages with key/value attributes. The attributes are
+// appended to the message as key=value pairs
+type Structured struct {
+	logger *Logger
+	attrs  string // Preformatted attributes added with With
+}
+
+// Structured returns a structured logger that writes through this logger and
+// honours its log level
+func (l *Logger) Structured() *Structured {
+	return &Structured{logger: l}
+}
+
+// With returns a structured logger that adds the given key/value attributes to every message
+func (s *Structured) With(args ...interface{}) *Structured {
+	return &Structured{logger: s.logger, attrs: s.attrs + formatAttrs(args)}
+}
+
+// Enabled returns true if messages of the given level are logged
+func (s *Structured) Enabled(_ context.Context, level LogLevel) bool {
+	// A zero Logger has no output
+	return s.logger.output != nil && level >= s.logger.logLevel
+}
+
+// Log logs the message with the given key/value attributes
+func (s *Structured) Log(ctx context.Context, level LogLevel, message string, args ...interface{}) {
+	if !s.Enabled(ctx, level) {
+		return
+	}
+	message += s.attrs + formatAttrs(args)
+	switch level {
+	case FATAL:
+		s.logger.Fatal("%s", message)
+	case logger.ERROR:
+		s.logger.log(level, s.logger.output.Error, message)
+	case logger.WARNING:
+		s.logger.log(level, s.logger.output.Warning, message)
+	case logger.INFO:
+		s.logger.log(level, s.logger.output.Info, message)
+	case logger.DEBUG:
+		s.logger.log(level, s.logger.output.Debug, message)
+	default:
+		s.logger.log(level, s.logger.output.Trace, message)
+	}
+}
+
+// formatAttrs formats key/value pairs like the key=value pairs of logger.NewSlogHandler
+func formatAttrs(args []interface{}) string {
+	var result strings.Builder
+	for len(args) > 0 {
+		key, value := "!BADKEY", args[0]
+		if name, ok := args[0].(string); ok && len(args) > 1 {
+			key, value = name, args[1]
+			args = args[2:]
+		} else {
+			args = args[1:]
+		}
+		formatted := fmt.Sprint(value)
+		if formatted == "" || strings.ContainsAny(formatted, " \t\n\"=") {
+			formatted = strconv.Quote(formatted)
+		}
+		result.WriteString(" " + key + "=" + formatted)
+	}
+	return result.String()
+}
@@ -0,0 +1,131 @@
+//go:build go1.21
+// +build go1.21
+
+package logger
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"os"
+	"time"
+
+	"github.com/wailsapp/wails/v2/pkg/logger"
+)
+
+// SetHandler sends all logs to the given slog handler instead of the output logger.
+// It must be called before any structured loggers are created using Slog.
+func (l *Logger) SetHandler(handler logger.SlogHandler) {
+	l.handler = handler
+}
+
+// Slog returns a structured logger that writes through this logger and
+// honours its log level
+func (l *Logger) Slog() *slog.Logger {
+	handler := l.handler
+	if handler == nil {
+		// A zero Logger has no output
+		if l.output != nil {
+			handler = logger.NewSlogHandler(l.output)
+		} else {
+			handler = slog.NewTextHandler(io.Discard, nil)
+		}
+	}
+	return slog.New(&levelHandler{logger: l, handler: handler})
+}
+
+// handle sends the message to the handler and returns false if no handler was set
+func (l *Logger) handle(level LogLevel, message string) bool {
+	if l.handler == nil {
+		return false
+	}
+	_ = l.handler.Handle(context.Background(), slog.NewRecord(time.Now(), slogLevel(level), message, 0))
+	return true
+}
+
+// Structured logs messages with key/value attributes through log/slog
+type Structured struct {
+	logger *slog.Logger
+}
+
+// Structured returns a structured logger that writes through this logger and
+// honours its log level
+func (l *Logger) Structured() *Structured {
+	return &Structured{logger: l.Slog()}
+}
+
+// With returns a structured logger that adds the given key/value attributes to every message
+func (s *Structured) With(args ...interface{}) *Structured {
+	return &Structured{logger: s.logger.With(args...)}
+}
+
+// Enabled returns true if messages of the given level are logged
+func (s *Structured) Enabled(ctx context.Context, level LogLevel) bool {
+	return s.logger.Enabled(ctx, slogLevel(level))
+}
+
+// Log logs the message with the given key/value attributes
+func (s *Structured) Log(ctx context.Context, level LogLevel, message string, args ...interface{}) {
+	s.logger.Log(ctx, slogLevel(level), message, args...)
+}
+
+// levelHandler filters structured logs using the log level of the Logger
+type levelHandler struct {
+	logger  *Logger
+	handler slog.Handler
+}
+
+func (h *levelHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	if level < logger.SlogLevelFatal && level < h.logger.logLevel.SlogLevel() {
+		return false
+	}
+	return h.handler.Enabled(ctx, level)
+}
+
+func (h *levelHandler) Handle(ctx context.Context, record slog.Record) error {
+	if h.logger.keepsRecent() {
+		message := record.Message
+		record.Attrs(func(attr slog.Attr) bool {
+			message += " " + attr.String()
+			return true
+		})
+		h.logger.remember(logLevel(record.Level), message)
+	}
+	err := h.handler.Handle(ctx, record)
+	if record.Level >= logger.SlogLevelFatal {
+		h.logger.Flush()
+		os.Exit(1)
+	}
+	return err
+}
+
+func (h *levelHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return &levelHandler{logger: h.logger, handler: h.handler.WithAttrs(attrs)}
+}
+
+func (h *levelHandler) WithGroup(name string) slog.Handler {
+	return &levelHandler{logger: h.logger, handler: h.handler.WithGroup(name)}
+}
+
+func slogLevel(level LogLevel) slog.Level {
+	if level == FATAL {
+		return logger.SlogLevelFatal
+	}
+	return level.SlogLevel()
+}
+
+func logLevel(level slog.Level) LogLevel {
+	switch {
+	case level < slog.LevelDebug:
+		return logger.TRACE
+	case level < slog.LevelInfo:
+		return logger.DEBUG
+	case level < slog.LevelWarn:
+		return logger.INFO
+	case level < slog.LevelError:
+		return logger.WARNING
+	case level < logger.SlogLevelFatal:
+		return logger.ERROR
+	}
+	return FATAL
+}
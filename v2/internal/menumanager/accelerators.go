@@ -0,0 +1,53 @@
+package menumanager
+
+import (
+	"context"
+	"sort"
+
+	pkgLogger "github.com/wailsapp/wails/v2/pkg/logger"
+	"github.com/wailsapp/wails/v2/pkg/menu"
+)
+
+// ValidateAccelerators checks the application, context and tray menus for accelerators used by more than
+// one item of the same menu. A warning is logged for each conflict and the conflicts are returned.
+func (m *Manager) ValidateAccelerators() []*menu.AcceleratorConflict {
+	m.lock.RLock()
+	applicationMenu := m.applicationMenu
+	m.lock.RUnlock()
+
+	result := m.validateAccelerators("application menu", applicationMenu)
+
+	contextMenuIDs := make([]string, 0, len(m.contextMenus))
+	for id := range m.contextMenus {
+		contextMenuIDs = append(contextMenuIDs, id)
+	}
+	sort.Strings(contextMenuIDs)
+	for _, id := range contextMenuIDs {
+		result = append(result, m.validateAccelerators("context menu '"+id+"'", m.contextMenus[id].menu)...)
+	}
+
+	trayMenuIDs := make([]string, 0, len(m.trayMenus))
+	for id := range m.trayMenus {
+		trayMenuIDs = append(trayMenuIDs, id)
+	}
+	sort.Strings(trayMenuIDs)
+	for _, id := range trayMenuIDs {
+		trayMenu := m.trayMenus[id]
+		result = append(result, m.validateAccelerators("tray menu '"+trayMenu.Label+"'", trayMenu.menu)...)
+	}
+
+	return result
+}
+
+func (m *Manager) validateAccelerators(name string, inmenu *menu.Menu) []*menu.AcceleratorConflict {
+	if inmenu == nil {
+		return nil
+	}
+	conflicts := menu.ValidateAccelerators(inmenu)
+	if m.log != nil {
+		for _, conflict := range conflicts {
+			m.log.Log(context.Background(), pkgLogger.WARNING, "conflicting accelerators", "menu", name, "conflict", conflict.String())
+		}
+	}
+	return conflicts
+}
@@ -1,6 +1,11 @@
 package menumanager
 
-import "github.com/wailsapp/wails/v2/pkg/menu"
+import (
+	"fmt"
+
+	"github.com/wailsapp/wails/v2/internal/frontend"
+	"github.com/wailsapp/wails/v2/pkg/menu"
+)
 
 func (m *Manager) SetApplicationMenu(applicationMenu *menu.Menu) error {
 
@@ -8,6 +13,8 @@ func (m *Manager) SetApplicationMenu(applicationMenu *menu.Menu) error {
 		return nil
 	}
 
+	m.lock.Lock()
+
 	m.applicationMenu = applicationMenu
 
 	// Reset the menu map
@@ -16,25 +23,86 @@ func (m *Manager) SetApplicationMenu(applicationMenu *menu.Menu) error {
 	// Add the menu to the menu map
 	m.applicationMenuItemMap.AddMenu(applicationMenu)
 
-	return m.processApplicationMenu()
+	err := m.processApplicationMenu()
+	m.lock.Unlock()
+	if err != nil {
+		return err
+	}
+
+	m.notifyApplicationMenuUpdated()
+	return nil
 }
 
 func (m *Manager) GetApplicationMenuJSON() string {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
 	return m.applicationMenuJSON
 }
 
 func (m *Manager) GetProcessedApplicationMenu() *WailsMenu {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
 	return m.processedApplicationMenu
 }
 
+// GetFrontendApplicationMenu returns the visible items of the application menu as sent to the frontend
+func (m *Manager) GetFrontendApplicationMenu() []*FrontendMenuItem {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+	if m.frontendApplicationMenu == nil {
+		return []*FrontendMenuItem{}
+	}
+	return m.frontendApplicationMenu
+}
+
 // UpdateApplicationMenu reprocesses the application menu to pick up structure
 // changes etc
 // Returns the JSON representation of the updated menu
 func (m *Manager) UpdateApplicationMenu() (string, error) {
+	m.lock.Lock()
 	m.applicationMenuItemMap = NewMenuItemMap()
 	m.applicationMenuItemMap.AddMenu(m.applicationMenu)
 	err := m.processApplicationMenu()
-	return m.applicationMenuJSON, err
+	applicationMenuJSON := m.applicationMenuJSON
+	m.lock.Unlock()
+	if err != nil {
+		return applicationMenuJSON, err
+	}
+
+	m.notifyApplicationMenuUpdated()
+	return applicationMenuJSON, nil
+}
+
+// ProcessApplicationMenuClick processes a click on an application menu item drawn by the frontend,
+// EG: in a custom title bar. The roles are performed by the sender.
+func (m *Manager) ProcessApplicationMenuClick(menuID string, sender frontend.Frontend) error {
+	m.lock.RLock()
+	menuItem := m.applicationMenuItemMap.getMenuItemByID(menuID)
+	m.lock.RUnlock()
+
+	if menuItem == nil {
+		return fmt.Errorf("Cannot process menuid %s - unknown", menuID)
+	}
+	if menuItem.Disabled {
+		return nil
+	}
+
+	if menuItem.Role != 0 {
+		go m.roleAction(menuItem.Role)(sender)
+		return nil
+	}
+
+	err := m.ProcessClick(menuID, "", "ApplicationMenu", "")
+
+	// Update the native menu and the frontends with the new state
+	if menuItem.Type == menu.CheckboxType || menuItem.Type == menu.RadioType {
+		if _, updateErr := m.UpdateApplicationMenu(); updateErr != nil {
+			return updateErr
+		}
+		sender.MenuUpdateApplicationMenu()
+	}
+
+	return err
 }
 
 func (m *Manager) processApplicationMenu() error {
@@ -47,5 +115,14 @@ func (m *Manager) processApplicationMenu() error {
 		return err
 	}
 	m.applicationMenuJSON = applicationMenuJSON
+
+	m.frontendApplicationMenu = newFrontendMenu(m.applicationMenuItemMap, m.applicationMenu)
 	return nil
 }
+
+// notifyApplicationMenuUpdated sends the updated application menu to the frontends
+func (m *Manager) notifyApplicationMenuUpdated() {
+	if m.events != nil {
+		m.events.Emit(applicationMenuUpdatedEvent, m.GetFrontendApplicationMenu())
+	}
+}
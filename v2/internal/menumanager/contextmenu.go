@@ -36,17 +36,38 @@ func NewContextMenu(contextMenu *menu.ContextMenu) *ContextMenu {
 	return result
 }
 
+// AddContextMenu adds the context menu so its clicks can be processed. Nil menus are ignored.
 func (m *Manager) AddContextMenu(contextMenu *menu.ContextMenu) {
+	if contextMenu == nil {
+		return
+	}
 
 	newContextMenu := NewContextMenu(contextMenu)
 
 	// Save the references
+	m.lock.Lock()
+	defer m.lock.Unlock()
 	m.contextMenus[contextMenu.ID] = newContextMenu
 	m.contextMenuPointers[contextMenu] = contextMenu.ID
+	m.processRadioGroups(newContextMenu.ProcessedMenu, newContextMenu.menuItemMap)
+}
+
+// ContextMenuItemID returns the ID used to process clicks on the item of the context menu
+func (m *Manager) ContextMenuItemID(contextMenuID string, menuItem *menu.MenuItem) (string, bool) {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+	contextMenu := m.contextMenus[contextMenuID]
+	if contextMenu == nil {
+		return "", false
+	}
+	id, ok := contextMenu.menuItemMap.menuItemToIDMap[menuItem]
+	return id, ok
 }
 
 func (m *Manager) UpdateContextMenu(contextMenu *menu.ContextMenu) (string, error) {
+	m.lock.RLock()
 	contextMenuID, contextMenuKnown := m.contextMenuPointers[contextMenu]
+	m.lock.RUnlock()
 	if !contextMenuKnown {
 		return "", fmt.Errorf("unknown Context Menu '%s'. Please add the context menu using AddContextMenu()", contextMenu.ID)
 	}
@@ -55,7 +76,10 @@ func (m *Manager) UpdateContextMenu(contextMenu *menu.ContextMenu) (string, erro
 	updatedContextMenu := NewContextMenu(contextMenu)
 
 	// Save the reference
+	m.lock.Lock()
 	m.contextMenus[contextMenuID] = updatedContextMenu
+	m.processRadioGroups(updatedContextMenu.ProcessedMenu, updatedContextMenu.menuItemMap)
+	m.lock.Unlock()
 
 	return updatedContextMenu.AsJSON()
 }
@@ -0,0 +1,154 @@
+package menumanager
+
+import (
+	goruntime "runtime"
+
+	"github.com/wailsapp/wails/v2/internal/frontend"
+	"github.com/wailsapp/wails/v2/pkg/menu"
+	"github.com/wailsapp/wails/v2/pkg/menu/keys"
+)
+
+// FrontendMenuItem is an application menu item as sent to the frontend, EG: to draw a custom title bar
+type FrontendMenuItem struct {
+	ID string
+	// Label is what appears as the menu text
+	Label string `json:",omitempty"`
+	// Accelerator is the key binding formatted for the current platform, EG: "Ctrl+Shift+S"
+	Accelerator string `json:",omitempty"`
+	// Type of MenuItem, EG: Checkbox, Text, Separator, Radio, Submenu
+	Type menu.Type
+	// Disabled makes the item unselectable
+	Disabled bool `json:",omitempty"`
+	// Checked indicates if the item is selected (used by Checkbox and Radio types only)
+	Checked bool `json:",omitempty"`
+	// Icon and IconDark hold PNG image data. Encoded as base64
+	Icon     []byte `json:",omitempty"`
+	IconDark []byte `json:",omitempty"`
+	// Tooltip is shown when the mouse hovers over the item
+	Tooltip string `json:",omitempty"`
+	// Sublabel is secondary text shown below the label
+	Sublabel string `json:",omitempty"`
+	// SubMenu contains the items of the submenu
+	SubMenu []*FrontendMenuItem `json:",omitempty"`
+}
+
+// selectedTextJS evaluates to the selected text of the page or the focused input
+const selectedTextJS = `(function(){const e=document.activeElement;if(e&&(e.tagName==="INPUT"||e.tagName==="TEXTAREA")){return e.value.substring(e.selectionStart,e.selectionEnd);}return window.getSelection().toString();})()`
+
+// roleActions perform the roles of the application menu items clicked in the frontend.
+// Roles without an action are not sent to the frontend.
+var roleActions = map[menu.Role]func(sender frontend.Frontend){
+	menu.UndoRole:               execJS(`document.execCommand("undo");`),
+	menu.RedoRole:               execJS(`document.execCommand("redo");`),
+	menu.CutRole:                execJS(`window.runtime.ClipboardSetText(` + selectedTextJS + `);document.execCommand("delete");`),
+	menu.CopyRole:               execJS(`window.runtime.ClipboardSetText(` + selectedTextJS + `);`),
+	menu.PasteRole:              execJS(`window.runtime.ClipboardGetText().then(function(text){document.execCommand("insertText",false,text);});`),
+	menu.PasteAndMatchStyleRole: execJS(`window.runtime.ClipboardGetText().then(function(text){document.execCommand("insertText",false,text);});`),
+	menu.SelectAllRole:          execJS(`document.execCommand("selectAll");`),
+	menu.DeleteRole:             execJS(`document.execCommand("delete");`),
+	menu.MinimizeRole:           frontend.Frontend.WindowMinimise,
+	menu.ZoomRole:               frontend.Frontend.WindowToggleMaximise,
+	menu.ToggleFullscreenRole:   toggleFullscreen,
+	menu.ReloadRole:             frontend.Frontend.WindowReload,
+	menu.CloseWindowRole:        frontend.Frontend.Quit, // See roleAction
+	menu.QuitRole:               frontend.Frontend.Quit,
+	menu.HideRole:               frontend.Frontend.Hide,
+}
+
+// roleAction returns the action that performs the role. Closing the window behaves like the
+// close button of the window: it hides the window if HideWindowOnClose is set and quits otherwise.
+func (m *Manager) roleAction(role menu.Role) func(sender frontend.Frontend) {
+	if role == menu.CloseWindowRole && m.hideWindowOnClose {
+		return frontend.Frontend.WindowHide
+	}
+	return roleActions[role]
+}
+
+func execJS(js string) func(sender frontend.Frontend) {
+	return func(sender frontend.Frontend) {
+		sender.ExecJS(js)
+	}
+}
+
+func toggleFullscreen(sender frontend.Frontend) {
+	if sender.WindowIsFullscreen() {
+		sender.WindowUnfullscreen()
+	} else {
+		sender.WindowFullscreen()
+	}
+}
+
+// newFrontendMenu returns the visible items of the menu. The items that implement
+// a role are added to the menu map so they can be clicked.
+func newFrontendMenu(menuItemMap *MenuItemMap, inmenu *menu.Menu) []*FrontendMenuItem {
+	result := []*FrontendMenuItem{}
+	if inmenu == nil {
+		return result
+	}
+	for _, item := range inmenu.Items {
+		if frontendMenuItem := newFrontendMenuItem(menuItemMap, item); frontendMenuItem != nil {
+			result = append(result, frontendMenuItem)
+		}
+	}
+	return result
+}
+
+func newFrontendMenuItem(menuItemMap *MenuItemMap, menuItem *menu.MenuItem) *FrontendMenuItem {
+	if menuItem.Hidden {
+		return nil
+	}
+	if menuItem.Role != 0 {
+		menuItem = frontendRoleMenuItem(menuItem)
+		if menuItem == nil {
+			return nil
+		}
+		menuItemMap.addMenuItem(menuItem)
+	}
+
+	result := &FrontendMenuItem{
+		ID:       menuItemMap.menuItemToIDMap[menuItem],
+		Label:    menuItem.Label,
+		Type:     menuItem.Type,
+		Disabled: menuItem.Disabled,
+		Checked:  menuItem.Checked,
+		Icon:     menuItem.Icon,
+		IconDark: menuItem.IconDark,
+		Tooltip:  menuItem.Tooltip,
+		Sublabel: menuItem.Sublabel,
+	}
+	if menuItem.Accelerator != nil {
+		result.Accelerator = keys.Stringify(menuItem.Accelerator, goruntime.GOOS)
+	}
+	if menuItem.SubMenu != nil {
+		result.SubMenu = newFrontendMenu(menuItemMap, menuItem.SubMenu)
+	}
+	return result
+}
+
+// frontendRoleMenuItem returns the menu item that implements the role of the given item.
+// Returns nil if the role can't be performed from the frontend.
+func frontendRoleMenuItem(menuItem *menu.MenuItem) *menu.MenuItem {
+	role := menuItem.Role
+	if role.IsMenu() {
+		submenu := role.Menu()
+		if submenu == nil {
+			return nil
+		}
+		return &menu.MenuItem{
+			Label:    role.Label(),
+			Type:     menu.SubmenuType,
+			SubMenu:  submenu,
+			Disabled: menuItem.Disabled,
+		}
+	}
+	if _, ok := roleActions[role]; !ok {
+		return nil
+	}
+	return &menu.MenuItem{
+		Label:       role.Label(),
+		Role:        role,
+		Type:        menu.TextType,
+		Accelerator: role.Accelerator(),
+		Disabled:    menuItem.Disabled,
+	}
+}
@@ -62,7 +62,11 @@ func (m *MenuItemMap) processMenuItem(item *menu.MenuItem) {
 		}
 	}
 
-	// Create a unique ID for this menu item
+	m.addMenuItem(item)
+}
+
+// addMenuItem creates a unique ID for the menu item, without its submenu
+func (m *MenuItemMap) addMenuItem(item *menu.MenuItem) {
 	menuID := m.generateMenuID()
 
 	// Store references
@@ -1,16 +1,29 @@
 package menumanager
 
 import (
+	"context"
 	"fmt"
+	"sync"
+
+	"github.com/wailsapp/wails/v2/internal/frontend"
+	"github.com/wailsapp/wails/v2/internal/logger"
+	pkgLogger "github.com/wailsapp/wails/v2/pkg/logger"
 	"github.com/wailsapp/wails/v2/pkg/menu"
 )
 
+// applicationMenuUpdatedEvent is emitted with the frontend menu when the application menu changes
+const applicationMenuUpdatedEvent = "menu:updated"
+
 type Manager struct {
 
+	// Guards the application menu, which can be updated while the frontend uses it
+	lock sync.RWMutex
+
 	// The application menu.
 	applicationMenu          *menu.Menu
 	applicationMenuJSON      string
 	processedApplicationMenu *WailsMenu
+	frontendApplicationMenu  []*FrontendMenuItem
 
 	// Our application menu mappings
 	applicationMenuItemMap *MenuItemMap
@@ -25,6 +38,15 @@ type Manager struct {
 
 	// Radio groups
 	radioGroups map[*menu.MenuItem][]*menu.MenuItem
+
+	// Structured logger. May be nil
+	log *logger.Structured
+
+	// Notifies the frontends of application menu updates. May be nil
+	events frontend.Events
+
+	// Indicates that closing the window hides it instead of quitting
+	hideWindowOnClose bool
 }
 
 func NewManager() *Manager {
@@ -38,6 +60,22 @@ func NewManager() *Manager {
 	}
 }
 
+// SetLogger sets the structured logger used to log menu clicks
+func (m *Manager) SetLogger(log *logger.Structured) {
+	m.log = log
+}
+
+// SetEvents sets the events used to notify the frontends of application menu updates
+func (m *Manager) SetEvents(events frontend.Events) {
+	m.events = events
+}
+
+// SetHideWindowOnClose sets whether the close window role hides the window instead of quitting,
+// like the close button of the window does
+func (m *Manager) SetHideWindowOnClose(hide bool) {
+	m.hideWindowOnClose = hide
+}
+
 func (m *Manager) getMenuItemByID(menuMap *MenuItemMap, menuId string) *menu.MenuItem {
 	return menuMap.idToMenuItemMap[menuId]
 }
@@ -46,6 +84,9 @@ func (m *Manager) ProcessClick(menuID string, data string, menuType string, pare
 
 	var menuItemMap *MenuItemMap
 
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
 	switch menuType {
 	case "ApplicationMenu":
 		menuItemMap = m.applicationMenuItemMap
@@ -68,9 +109,16 @@ func (m *Manager) ProcessClick(menuID string, data string, menuType string, pare
 	// Get the menu item
 	menuItem := menuItemMap.getMenuItemByID(menuID)
 	if menuItem == nil {
+		if m.log != nil {
+			m.log.Log(context.Background(), pkgLogger.WARNING, "unknown menu item clicked", "menuID", menuID, "menuType", menuType)
+		}
 		return fmt.Errorf("Cannot process menuid %s - unknown", menuID)
 	}
 
+	if m.log != nil {
+		m.log.Log(context.Background(), pkgLogger.TRACE, "menu item clicked", "menuID", menuID, "label", menuItem.Label, "menuType", menuType)
+	}
+
 	// Is the menu item a checkbox?
 	if menuItem.Type == menu.CheckboxType {
 		// Toggle state
@@ -92,8 +140,8 @@ func (m *Manager) ProcessClick(menuID string, data string, menuType string, pare
 
 	// Create new Callback struct
 	callbackData := &menu.CallbackData{
-		MenuItem: menuItem,
-		//ContextData: data,
+		MenuItem:    menuItem,
+		ContextData: data,
 	}
 
 	// Call back!
@@ -25,6 +25,14 @@ type ProcessedMenuItem struct {
 	// Submenu contains a list of menu items that will be shown as a submenu
 	//SubMenu []*MenuItem `json:"SubMenu,omitempty"`
 	SubMenu *ProcessedMenu `json:",omitempty"`
+
+	// Icon and IconDark hold PNG image data. Encoded as base64
+	Icon     []byte `json:",omitempty"`
+	IconDark []byte `json:",omitempty"`
+	// Tooltip is shown when the mouse hovers over the item
+	Tooltip string `json:",omitempty"`
+	// Sublabel is secondary text shown below the label
+	Sublabel string `json:",omitempty"`
 	/*
 		// Colour
 		RGBA string `json:",omitempty"`
@@ -33,14 +41,9 @@ type ProcessedMenuItem struct {
 		FontSize int    `json:",omitempty"`
 		FontName string `json:",omitempty"`
 
-		// Image - base64 image data
-		Image            string `json:",omitempty"`
 		MacTemplateImage bool   `json:", omitempty"`
 		MacAlternate     bool   `json:", omitempty"`
 
-		// Tooltip
-		Tooltip string `json:",omitempty"`
-
 		// Styled label
 		StyledLabel []*ansi.StyledText `json:",omitempty"`
 	*/
@@ -70,13 +73,15 @@ func NewProcessedMenuItem(menuItemMap *MenuItemMap, menuItem *menu.MenuItem) *Pr
 		Hidden:      menuItem.Hidden,
 		Checked:     menuItem.Checked,
 		SubMenu:     nil,
+		Icon:        menuItem.Icon,
+		IconDark:    menuItem.IconDark,
+		Tooltip:     menuItem.Tooltip,
+		Sublabel:    menuItem.Sublabel,
 		//BackgroundColour:             menuItem.BackgroundColour,
 		//FontSize:         menuItem.FontSize,
 		//FontName:         menuItem.FontName,
-		//Image:            menuItem.Image,
 		//MacTemplateImage: menuItem.MacTemplateImage,
 		//MacAlternate:     menuItem.MacAlternate,
-		//Tooltip:          menuItem.Tooltip,
 		//StyledLabel:      styledLabel,
 	}
 
@@ -107,9 +112,8 @@ func NewProcessedMenu(menuItemMap *MenuItemMap, menu *menu.Menu) *ProcessedMenu
 // WailsMenu is the original menu with the addition
 // of radio groups extracted from the menu data
 type WailsMenu struct {
-	Menu              *ProcessedMenu
-	RadioGroups       []*RadioGroup
-	currentRadioGroup []string
+	Menu        *ProcessedMenu
+	RadioGroups []*RadioGroup
 }
 
 // RadioGroup holds all the members of the same radio group
@@ -125,7 +129,7 @@ func NewWailsMenu(menuItemMap *MenuItemMap, menu *menu.Menu) *WailsMenu {
 	result.Menu = NewProcessedMenu(menuItemMap, menu)
 
 	// Process the radio groups
-	result.processRadioGroups()
+	result.processRadioGroups(menuItemMap, menu)
 
 	return result
 }
@@ -139,51 +143,17 @@ func (w *WailsMenu) AsJSON() (string, error) {
 	return string(menuAsJSON), nil
 }
 
-func (w *WailsMenu) processRadioGroups() {
-	// Loop over top level menus
-	for _, item := range w.Menu.Items {
-		// Process MenuItem
-		w.processMenuItem(item)
+func (w *WailsMenu) processRadioGroups(menuItemMap *MenuItemMap, menu *menu.Menu) {
+	if menu == nil {
+		return
 	}
-
-	w.finaliseRadioGroup()
-}
-
-func (w *WailsMenu) processMenuItem(item *ProcessedMenuItem) {
-
-	switch item.Type {
-
-	// We need to recurse submenus
-	case menu.SubmenuType:
-
-		// Finalise any current radio groups as they don't trickle down to submenus
-		w.finaliseRadioGroup()
-
-		// Process each submenu item
-		for _, subitem := range item.SubMenu.Items {
-			w.processMenuItem(subitem)
-		}
-	case menu.RadioType:
-		// Add the item to the radio group
-		w.currentRadioGroup = append(w.currentRadioGroup, item.ID)
-	default:
-		w.finaliseRadioGroup()
-	}
-}
-
-func (w *WailsMenu) finaliseRadioGroup() {
-
-	// If we were processing a radio group, fix up the references
-	if len(w.currentRadioGroup) > 0 {
-
-		// Create new radiogroup
+	for _, radioGroup := range menu.RadioGroups() {
 		group := &RadioGroup{
-			Members: w.currentRadioGroup,
-			Length:  len(w.currentRadioGroup),
+			Length: len(radioGroup),
+		}
+		for _, item := range radioGroup {
+			group.Members = append(group.Members, menuItemMap.menuItemToIDMap[item])
 		}
 		w.RadioGroups = append(w.RadioGroups, group)
-
-		// Empty the radio group
-		w.currentRadioGroup = []string{}
 	}
 }
@@ -30,6 +30,9 @@ type Project struct {
 	// The url of the external wails dev server. If this is set, this server is used for the frontend. Default ""
 	FrontendDevServerURL string `json:"frontend:dev:serverUrl"`
 
+	// Write gzip and brotli compressed siblings of the embedded assets after building the frontend. Default false
+	Precompress bool `json:"frontend:precompress,omitempty"`
+
 	// Directory to generate the API Module
 	WailsJSDir string `json:"wailsjsdir"`
 
@@ -59,13 +62,17 @@ type Project struct {
 	// Key: GOOS/GOARCH - Executed at build level before/after a build of the specific platform and arch
 	// Key: GOOS/*      - Executed at build level before/after a build of the specific platform
 	// Key: */*         - Executed at build level before/after a build
-	// The following keys are not yet supported.
 	// Key: GOOS        - Executed at platform level before/after all builds of the specific platform
 	// Key: *           - Executed at platform level before/after all builds of a platform
 	// Key: [empty]     - Executed at global level before/after all builds of all platforms
+	// Pre build hooks are executed from the global level down to the build level, post build hooks
+	// from the build level up to the global level.
 	PostBuildHooks map[string]string `json:"postBuildHooks"`
 	PreBuildHooks  map[string]string `json:"preBuildHooks"`
 
+	// The maximum number of seconds a build hook may run. Default 0 (no limit)
+	BuildHookTimeout int `json:"buildHookTimeout,omitempty"`
+
 	// The application author
 	Author Author
 
@@ -95,6 +102,9 @@ type Project struct {
 	FrontendDir string `json:"frontend:dir"`
 
 	Bindings Bindings `json:"bindings"`
+
+	// Software bill of materials settings
+	SBOM SBOM `json:"sbom,omitempty"`
 }
 
 func (p *Project) GetFrontendDir() string {
@@ -223,6 +233,18 @@ type Info struct {
 	Comments       *string `json:"comments"`
 }
 
+// SBOM stores the software bill of materials settings
+type SBOM struct {
+	// The format of the generated bill of materials: "cyclonedx" or "spdx". Default "cyclonedx"
+	Format string `json:"format,omitempty"`
+
+	// SPDX identifiers of licenses that dependencies may not use
+	DisallowedLicenses []string `json:"disallowedLicenses,omitempty"`
+
+	// Fail the build if a dependency uses a disallowed license. Otherwise a warning is shown
+	FailOnDisallowedLicense bool `json:"failOnDisallowedLicense,omitempty"`
+}
+
 type Bindings struct {
 	TsGeneration TsGeneration `json:"ts_generation"`
 }
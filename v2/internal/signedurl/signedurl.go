@@ -0,0 +1,90 @@
+// Package signedurl signs the URLs of files served by the AssetServer outside of its configured routes.
+// The path and expiry of the file are encrypted into an opaque token, so the URL doesn't disclose the path
+// and can't be changed to request other files. The key is created when the application starts, so URLs are
+// only valid while it runs.
+package signedurl
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// Path is the path prefix of signed file URLs
+const Path = "/wails/file/"
+
+var (
+	ErrInvalidSignature = errors.New("invalid signature")
+	ErrExpired          = errors.New("signed URL has expired")
+)
+
+var aead = newAEAD()
+
+func newAEAD() cipher.AEAD {
+	key := make([]byte, 32)
+	if _, err := rand.Read(key); err != nil {
+		panic(err)
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		panic(err)
+	}
+	result, err := cipher.NewGCM(block)
+	if err != nil {
+		panic(err)
+	}
+	return result
+}
+
+// Sign returns a URL path that serves the given file until the expiry has passed. The last
+// path segment is the name of the file, so the URL has the extension of the file.
+func Sign(filename string, expiry time.Duration) (string, error) {
+	filename, err := filepath.Abs(filename)
+	if err != nil {
+		return "", err
+	}
+	info, err := os.Stat(filename)
+	if err != nil {
+		return "", err
+	}
+	if !info.Mode().IsRegular() {
+		return "", errors.New("not a regular file: " + filename)
+	}
+
+	// The token is the nonce followed by the encrypted expiry and filename
+	plaintext := make([]byte, 8, 8+len(filename))
+	binary.BigEndian.PutUint64(plaintext, uint64(time.Now().Add(expiry).Unix()))
+	plaintext = append(plaintext, filename...)
+	nonce := make([]byte, aead.NonceSize(), aead.NonceSize()+len(plaintext)+aead.Overhead())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", err
+	}
+	token := aead.Seal(nonce, nonce, plaintext, nil)
+	return Path + base64.RawURLEncoding.EncodeToString(token) + "/" + url.PathEscape(filepath.Base(filename)), nil
+}
+
+// Verify returns the file of the path of a signed URL if the signature is valid and the URL has not expired
+func Verify(urlPath string) (string, error) {
+	encoded, _, _ := strings.Cut(strings.TrimPrefix(urlPath, Path), "/")
+	token, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil || len(token) < aead.NonceSize() {
+		return "", ErrInvalidSignature
+	}
+	nonce, ciphertext := token[:aead.NonceSize()], token[aead.NonceSize():]
+	plaintext, err := aead.Open(nil, nonce, ciphertext, nil)
+	if err != nil || len(plaintext) <= 8 {
+		return "", ErrInvalidSignature
+	}
+	if time.Now().Unix() > int64(binary.BigEndian.Uint64(plaintext)) {
+		return "", ErrExpired
+	}
+	return string(plaintext[8:]), nil
+}
@@ -0,0 +1,58 @@
+package signedurl
+
+import (
+	"encoding/base64"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/matryer/is"
+)
+
+func TestSignVerify(t *testing.T) {
+	is2 := is.New(t)
+
+	filename := filepath.Join(t.TempDir(), "photo 1.jpg")
+	is2.NoErr(os.WriteFile(filename, []byte("jpg"), 0644))
+
+	signed, err := Sign(filename, time.Minute)
+	is2.NoErr(err)
+	is2.True(strings.HasPrefix(signed, Path))
+	is2.True(strings.HasSuffix(signed, "/photo%201.jpg"))
+	// The path of the file is not disclosed
+	is2.True(!strings.Contains(signed, url.PathEscape(filepath.Dir(filename))))
+
+	parsed, err := url.Parse(signed)
+	is2.NoErr(err)
+	verified, err := Verify(parsed.Path)
+	is2.NoErr(err)
+	is2.Equal(verified, filename)
+
+	// The name of the file is only used for the extension
+	verified, err = Verify(strings.TrimSuffix(parsed.Path, "photo 1.jpg") + "other.jpg")
+	is2.NoErr(err)
+	is2.Equal(verified, filename)
+
+	// The token can't be changed to request other files or extend the expiry
+	token, _, _ := strings.Cut(strings.TrimPrefix(parsed.Path, Path), "/")
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	is2.NoErr(err)
+	data[len(data)-1] ^= 1
+	_, err = Verify(Path + base64.RawURLEncoding.EncodeToString(data) + "/photo.jpg")
+	is2.Equal(err, ErrInvalidSignature)
+	_, err = Verify(Path + "photo.jpg")
+	is2.Equal(err, ErrInvalidSignature)
+
+	expired, err := Sign(filename, -time.Minute)
+	is2.NoErr(err)
+	parsed, err = url.Parse(expired)
+	is2.NoErr(err)
+	_, err = Verify(parsed.Path)
+	is2.Equal(err, ErrExpired)
+
+	_, err = Sign(filepath.Dir(filename), time.Minute)
+	is2.True(err != nil) // Directories can't be signed
+}
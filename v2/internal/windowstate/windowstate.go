@@ -0,0 +1,343 @@
+package windowstate
+
+import (
+	"context"
+	"sync"
+
+	"github.com/wailsapp/wails/v2/internal/frontend"
+	"github.com/wailsapp/wails/v2/internal/fs"
+	"github.com/wailsapp/wails/v2/internal/logger"
+	"github.com/wailsapp/wails/v2/pkg/options"
+	"github.com/wailsapp/wails/v2/pkg/runtime"
+	"github.com/wailsapp/wails/v2/pkg/windowstate"
+)
+
+const defaultKey = "main"
+
+// minVisible is the width and height of the part of the window, including the title bar,
+// that must be on the primary screen for the saved position to be restored
+const minVisible = 50
+
+// Manager restores the window state on startup, keeps track of the changes of the window
+// and saves the state when the application quits. A nil Manager does nothing.
+//
+// The position is saved and restored with WindowGetAbsolutePosition and
+// WindowSetAbsolutePosition, so it does not depend on the screen the window is on.
+type Manager struct {
+	key    string
+	store  windowstate.Store
+	logger *logger.Logger
+
+	// saved is the state that Apply restores, or nil if there is none
+	saved *windowstate.State
+	// startState is the state the window is put in once it has been placed
+	startState options.WindowStartState
+	// show is true if the window is shown once it has been placed
+	show bool
+
+	lock      sync.Mutex
+	state     *windowstate.State
+	minimised bool
+}
+
+// New creates a Manager that saves the window state in the configured store
+func New(stateOptions *options.WindowState, log *logger.Logger) (*Manager, error) {
+	result := &Manager{
+		key:    stateOptions.Key,
+		store:  stateOptions.Store,
+		logger: log,
+	}
+	if result.key == "" {
+		result.key = defaultKey
+	}
+	if result.store == nil {
+		dir, err := windowstate.DefaultDirectory(fs.ExecutableName())
+		if err != nil {
+			return nil, err
+		}
+		result.store = windowstate.NewFileStore(dir)
+	}
+	return result, nil
+}
+
+// Apply loads the saved window state and changes the application options so that the window
+// is created with the saved size. If there is a saved state, the window starts hidden and is
+// shown by the OnStartup callback once it has been moved to the saved position.
+func (m *Manager) Apply(appoptions *options.App) {
+	state, err := m.store.Load(m.key)
+	if err != nil {
+		m.logger.Error("Unable to load the window state: %s", err.Error())
+		return
+	}
+	if state == nil || state.Width <= 0 || state.Height <= 0 {
+		return
+	}
+	m.saved = state
+
+	appoptions.Width = clamp(state.Width, appoptions.MinWidth, appoptions.MaxWidth)
+	appoptions.Height = clamp(state.Height, appoptions.MinHeight, appoptions.MaxHeight)
+
+	m.startState = appoptions.WindowStartState
+	switch {
+	case state.Fullscreen:
+		m.startState = options.Fullscreen
+	case state.Maximised:
+		m.startState = options.Maximised
+	}
+	m.show = !appoptions.StartHidden
+	appoptions.WindowStartState = options.Normal
+	appoptions.StartHidden = true
+}
+
+// WrapStartup returns an OnStartup callback that restores the saved window state, starts
+// keeping track of the window and then calls the given callback
+func (m *Manager) WrapStartup(callback func(ctx context.Context)) func(ctx context.Context) {
+	return func(ctx context.Context) {
+		restored := m.restore(ctx)
+		if m.saved != nil {
+			// The window was hidden by Apply
+			if m.show {
+				runtime.WindowShow(ctx)
+			}
+			switch m.startState {
+			case options.Fullscreen:
+				runtime.WindowFullscreen(ctx)
+			case options.Maximised:
+				runtime.WindowMaximise(ctx)
+			case options.Minimised:
+				runtime.WindowMinimise(ctx)
+			}
+		}
+		m.track(ctx, restored)
+		if callback != nil {
+			callback(ctx)
+		}
+	}
+}
+
+// Save saves the latest state of the window
+func (m *Manager) Save() {
+	if m == nil {
+		return
+	}
+	m.lock.Lock()
+	if m.state == nil {
+		m.lock.Unlock()
+		return
+	}
+	state := *m.state
+	m.lock.Unlock()
+
+	if err := m.store.Save(m.key, &state); err != nil {
+		m.logger.Error("Unable to save the window state: %s", err.Error())
+	}
+}
+
+// restore moves the window to the saved position, now that the current screens are known.
+// It returns the restored state or nil if there is none.
+func (m *Manager) restore(ctx context.Context) *windowstate.State {
+	if m.saved == nil {
+		return nil
+	}
+	state := *m.saved
+	screens, err := runtime.ScreenGetAll(ctx)
+	if err != nil {
+		m.logger.Error("Unable to restore the window state: %s", err.Error())
+		return nil
+	}
+
+	width, height, visible := placement(&state, screens)
+	if width != state.Width || height != state.Height {
+		state.Width, state.Height = width, height
+		runtime.WindowSetSize(ctx, width, height)
+	}
+	if visible {
+		appFrontend(ctx).WindowSetAbsolutePosition(state.X, state.Y)
+	} else {
+		runtime.WindowCenter(ctx)
+		state.X, state.Y = appFrontend(ctx).WindowGetAbsolutePosition()
+	}
+	state.Fullscreen = m.startState == options.Fullscreen
+	state.Maximised = m.startState == options.Maximised
+	return &state
+}
+
+// track keeps track of the size, position and state of the window from the window events
+func (m *Manager) track(ctx context.Context, restored *windowstate.State) {
+	state := restored
+	if state == nil {
+		state = &windowstate.State{
+			Maximised:  runtime.WindowIsMaximised(ctx),
+			Fullscreen: runtime.WindowIsFullscreen(ctx),
+		}
+		state.Width, state.Height = runtime.WindowGetSize(ctx)
+		state.X, state.Y = appFrontend(ctx).WindowGetAbsolutePosition()
+	}
+	m.lock.Lock()
+	m.state = state
+	m.lock.Unlock()
+	m.updateScreens(ctx)
+
+	runtime.OnWindowEvent(ctx, runtime.WindowEventResize, func(event *runtime.WindowEvent) {
+		if m.update(func(state *windowstate.State) {
+			state.Width, state.Height = event.Size.Width, event.Size.Height
+		}) {
+			m.updateScreens(ctx)
+		}
+	})
+	runtime.OnWindowEvent(ctx, runtime.WindowEventMove, func(event *runtime.WindowEvent) {
+		// The position of the event is relative to the screen the window is on on some platforms
+		x, y := appFrontend(ctx).WindowGetAbsolutePosition()
+		if m.update(func(state *windowstate.State) {
+			state.X, state.Y = x, y
+		}) {
+			m.updateScreens(ctx)
+		}
+	})
+	for _, kind := range []runtime.WindowEventKind{runtime.WindowEventMinimise, runtime.WindowEventMaximise, runtime.WindowEventFullscreen, runtime.WindowEventRestore} {
+		runtime.OnWindowEvent(ctx, kind, func(event *runtime.WindowEvent) {
+			m.stateChanged(event.Kind)
+		})
+	}
+}
+
+// update changes the size or position of the window. The change is ignored if the window is
+// minimised, maximised or fullscreen, so the size and position it returns to are saved.
+func (m *Manager) update(change func(state *windowstate.State)) bool {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if m.minimised || m.state.Maximised || m.state.Fullscreen {
+		return false
+	}
+	change(m.state)
+	return true
+}
+
+func (m *Manager) stateChanged(kind runtime.WindowEventKind) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	switch kind {
+	case runtime.WindowEventMinimise:
+		// Keep the state the window returns to
+		m.minimised = true
+	case runtime.WindowEventMaximise:
+		m.minimised = false
+		m.state.Maximised = true
+		m.state.Fullscreen = false
+	case runtime.WindowEventFullscreen:
+		m.minimised = false
+		m.state.Fullscreen = true
+	case runtime.WindowEventRestore:
+		m.minimised = false
+		m.state.Maximised = false
+		m.state.Fullscreen = false
+	}
+}
+
+// updateScreens saves the screen layout and the screen the window is on
+func (m *Manager) updateScreens(ctx context.Context) {
+	screens, err := runtime.ScreenGetAll(ctx)
+	if err != nil {
+		return
+	}
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.state.Screens = identities(screens)
+	for _, screen := range screens {
+		if screen.IsCurrent {
+			m.state.Screen = identity(screen)
+		}
+	}
+}
+
+// placement returns the size of the saved window that fits on its screen and whether the
+// saved position is still visible on the current screens. The position is visible if the
+// screens are the same as when the state was saved, or if the window is on the primary screen,
+// which is at the origin of the coordinates of the saved position.
+func placement(state *windowstate.State, screens []frontend.Screen) (width int, height int, visible bool) {
+	current := identities(screens)
+	var primary windowstate.Screen
+	for _, screen := range current {
+		if screen.IsPrimary {
+			primary = screen
+			break
+		}
+	}
+	if primary.Width <= 0 || primary.Height <= 0 {
+		return state.Width, state.Height, false
+	}
+
+	sameLayout := sameScreens(current, state.Screens) && containsScreen(current, state.Screen)
+	screen := primary
+	if sameLayout {
+		screen = state.Screen
+	}
+	width = minInt(state.Width, screen.Width)
+	height = minInt(state.Height, screen.Height)
+	if sameLayout {
+		return width, height, true
+	}
+
+	visible = state.X+width >= minVisible && state.X <= primary.Width-minVisible &&
+		state.Y >= 0 && state.Y <= primary.Height-minVisible
+	return width, height, visible
+}
+
+func identity(screen frontend.Screen) windowstate.Screen {
+	return windowstate.Screen{
+		Width:     screen.Size.Width,
+		Height:    screen.Size.Height,
+		IsPrimary: screen.IsPrimary,
+	}
+}
+
+func identities(screens []frontend.Screen) []windowstate.Screen {
+	result := make([]windowstate.Screen, 0, len(screens))
+	for _, screen := range screens {
+		result = append(result, identity(screen))
+	}
+	return result
+}
+
+func sameScreens(a []windowstate.Screen, b []windowstate.Screen) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func containsScreen(screens []windowstate.Screen, screen windowstate.Screen) bool {
+	for _, current := range screens {
+		if current == screen {
+			return true
+		}
+	}
+	return false
+}
+
+// clamp limits the size to the minimum and maximum size of the window, which are ignored if zero
+func clamp(size int, minimum int, maximum int) int {
+	if maximum > 0 && size > maximum {
+		size = maximum
+	}
+	if size < minimum {
+		size = minimum
+	}
+	return size
+}
+
+func minInt(a int, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func appFrontend(ctx context.Context) frontend.Frontend {
+	return ctx.Value("frontend").(frontend.Frontend)
+}
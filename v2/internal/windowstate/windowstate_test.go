@@ -0,0 +1,152 @@
+package windowstate
+
+import (
+	"context"
+	"testing"
+
+	"github.com/matryer/is"
+	"github.com/wailsapp/wails/v2/internal/frontend"
+	"github.com/wailsapp/wails/v2/internal/frontend/runtime"
+	"github.com/wailsapp/wails/v2/internal/logger"
+	"github.com/wailsapp/wails/v2/pkg/options"
+	"github.com/wailsapp/wails/v2/pkg/windowstate"
+)
+
+func screen(width int, height int, primary bool) frontend.Screen {
+	return frontend.Screen{IsPrimary: primary, Size: frontend.ScreenSize{Width: width, Height: height}}
+}
+
+func TestPlacement(t *testing.T) {
+	laptop := windowstate.Screen{Width: 1440, Height: 900, IsPrimary: true}
+	monitor := windowstate.Screen{Width: 2560, Height: 1440}
+	tests := []struct {
+		name          string
+		state         *windowstate.State
+		screens       []frontend.Screen
+		width, height int
+		visible       bool
+	}{
+		{
+			name:    "same layout on secondary screen",
+			state:   &windowstate.State{Width: 2000, Height: 1200, X: 1600, Y: 100, Screen: monitor, Screens: []windowstate.Screen{laptop, monitor}},
+			screens: []frontend.Screen{screen(1440, 900, true), screen(2560, 1440, false)},
+			width:   2000,
+			height:  1200,
+			visible: true,
+		},
+		{
+			name:    "secondary screen disconnected",
+			state:   &windowstate.State{Width: 2000, Height: 1200, X: 1600, Y: 100, Screen: monitor, Screens: []windowstate.Screen{laptop, monitor}},
+			screens: []frontend.Screen{screen(1440, 900, true)},
+			width:   1440,
+			height:  900,
+			visible: false,
+		},
+		{
+			name:    "visible on primary screen after layout change",
+			state:   &windowstate.State{Width: 800, Height: 600, X: 100, Y: 100, Screen: laptop, Screens: []windowstate.Screen{laptop, monitor}},
+			screens: []frontend.Screen{screen(1440, 900, true)},
+			width:   800,
+			height:  600,
+			visible: true,
+		},
+		{
+			name:    "title bar above primary screen",
+			state:   &windowstate.State{Width: 800, Height: 600, X: 100, Y: -20, Screen: laptop},
+			screens: []frontend.Screen{screen(1440, 900, true)},
+			width:   800,
+			height:  600,
+			visible: false,
+		},
+		{
+			name:    "primary screen resolution changed",
+			state:   &windowstate.State{Width: 800, Height: 600, X: 1400, Y: 100, Screen: monitor, Screens: []windowstate.Screen{{Width: 2560, Height: 1440, IsPrimary: true}}},
+			screens: []frontend.Screen{screen(1440, 900, true)},
+			width:   800,
+			height:  600,
+			visible: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			is2 := is.New(t)
+			width, height, visible := placement(tt.state, tt.screens)
+			is2.Equal(width, tt.width)
+			is2.Equal(height, tt.height)
+			is2.Equal(visible, tt.visible)
+		})
+	}
+}
+
+// fakeFrontend is a window on a screen with a panel at the top, so WindowSetPosition is
+// relative to a work area that does not start at the top left corner of the screen
+type fakeFrontend struct {
+	frontend.Frontend
+	workAreaY int
+
+	x, y  int
+	shown bool
+}
+
+func (f *fakeFrontend) ScreenGetAll() ([]frontend.Screen, error) {
+	primary := screen(1920, 1080, true)
+	primary.IsCurrent = true
+	return []frontend.Screen{primary}, nil
+}
+
+func (f *fakeFrontend) WindowSetPosition(x int, y int) {
+	f.x, f.y = x, f.workAreaY+y
+}
+
+func (f *fakeFrontend) WindowGetPosition() (int, int) {
+	return f.x, f.y
+}
+
+func (f *fakeFrontend) WindowSetAbsolutePosition(x int, y int) {
+	f.x, f.y = x, y
+}
+
+func (f *fakeFrontend) WindowGetAbsolutePosition() (int, int) {
+	return f.x, f.y
+}
+
+func (f *fakeFrontend) WindowShow() {
+	f.shown = true
+}
+
+func TestRestoreWithWorkAreaOffset(t *testing.T) {
+	is2 := is.New(t)
+
+	primary := windowstate.Screen{Width: 1920, Height: 1080, IsPrimary: true}
+	store := windowstate.NewFileStore(t.TempDir())
+	is2.NoErr(store.Save(defaultKey, &windowstate.State{
+		Width: 800, Height: 600, X: 100, Y: 80,
+		Screen: primary, Screens: []windowstate.Screen{primary},
+	}))
+
+	log := logger.New(nil)
+	manager, err := New(&options.WindowState{Persist: true, Store: store}, log)
+	is2.NoErr(err)
+
+	appoptions := &options.App{Width: 1024, Height: 768}
+	manager.Apply(appoptions)
+	is2.Equal(appoptions.Width, 800)
+	is2.Equal(appoptions.Height, 600)
+	is2.True(appoptions.StartHidden)
+
+	window := &fakeFrontend{workAreaY: 32, x: 560, y: 240}
+	ctx := context.WithValue(context.Background(), "frontend", window)
+	ctx = context.WithValue(ctx, "events", runtime.NewEvents(log))
+	manager.WrapStartup(nil)(ctx)
+
+	// The window is shown at the saved position, not moved down by the panel
+	is2.True(window.shown)
+	is2.Equal(window.x, 100)
+	is2.Equal(window.y, 80)
+
+	manager.Save()
+	state, err := store.Load(defaultKey)
+	is2.NoErr(err)
+	is2.Equal(state.X, 100)
+	is2.Equal(state.Y, 80)
+}
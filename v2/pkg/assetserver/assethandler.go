@@ -12,6 +12,8 @@ import (
 	"path"
 	"strings"
 
+	"github.com/wailsapp/wails/v2/internal/frontend"
+	"github.com/wailsapp/wails/v2/internal/logger"
 	"github.com/wailsapp/wails/v2/pkg/options/assetserver"
 )
 
@@ -20,6 +22,11 @@ type Logger interface {
 	Error(message string, args ...interface{})
 }
 
+// StructuredLogger is implemented by loggers that also support structured logging
+type StructuredLogger interface {
+	Structured() *logger.Structured
+}
+
 //go:embed defaultindex.html
 var defaultHTML []byte
 
@@ -31,6 +38,11 @@ type assetHandler struct {
 	fs      iofs.FS
 	handler http.Handler
 
+	cacheControl   []assetserver.CacheControlRule
+	etags          etagCache
+	fileRoutes     []assetserver.FileRoute
+	signedFileURLs bool
+
 	logger Logger
 
 	retryMissingFiles bool
@@ -66,9 +78,12 @@ func NewAssetHandler(options assetserver.Options, log Logger) (http.Handler, err
 	}
 
 	var result http.Handler = &assetHandler{
-		fs:      vfs,
-		handler: options.Handler,
-		logger:  log,
+		fs:             vfs,
+		handler:        options.Handler,
+		cacheControl:   options.CacheControl,
+		fileRoutes:     options.FileRoutes,
+		signedFileURLs: options.SignedFileURLs,
+		logger:         log,
 	}
 
 	if middleware := options.Middleware; middleware != nil {
@@ -79,16 +94,22 @@ func NewAssetHandler(options assetserver.Options, log Logger) (http.Handler, err
 }
 
 func (d *assetHandler) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
+	if d.serveFileRoute(rw, req) {
+		return
+	}
+
 	url := req.URL.Path
 	handler := d.handler
 	if strings.EqualFold(req.Method, http.MethodGet) {
 		filename := path.Clean(strings.TrimPrefix(url, "/"))
 
 		d.logDebug("Handling request '%s' (file='%s')", url, filename)
+		setServedFrom(req, frontend.ServedFromFS)
 		if err := d.serveFSFile(rw, req, filename); err != nil {
 			if os.IsNotExist(err) {
 				if handler != nil {
 					d.logDebug("File '%s' not found, serving '%s' by AssetHandler", filename, url)
+					setServedFrom(req, frontend.ServedFromHandler)
 					handler.ServeHTTP(rw, req)
 					err = nil
 				} else {
@@ -104,6 +125,7 @@ func (d *assetHandler) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
 		}
 	} else if handler != nil {
 		d.logDebug("No GET request, serving '%s' by AssetHandler", url)
+		setServedFrom(req, frontend.ServedFromHandler)
 		handler.ServeHTTP(rw, req)
 	} else {
 		rw.WriteHeader(http.StatusMethodNotAllowed)
@@ -169,12 +191,35 @@ func (d *assetHandler) serveFSFile(rw http.ResponseWriter, req *http.Request, fi
 		}
 	}
 
+	name := statInfo.Name()
+	servedFilename := filename
+	if encodedFile, encodedStatInfo, encoding := d.openPrecompressed(rw, req, filename); encodedFile != nil {
+		defer encodedFile.Close()
+		rw.Header().Set(HeaderContentEncoding, encoding)
+		file, statInfo, n = encodedFile, encodedStatInfo, 0
+		servedFilename += precompressedExtension(encoding)
+	}
+
+	d.setCacheControl(rw, req)
+	if statInfo.ModTime().IsZero() {
+		// Without a modification time the content can't be revalidated, so a hash of the content is used
+		etag, err := d.etags.get(d.fs, servedFilename)
+		if err != nil {
+			return err
+		}
+		rw.Header().Set(HeaderETag, etag)
+		if etagMatches(req, etag) {
+			writeNotModified(rw)
+			return nil
+		}
+	}
+
 	if fileSeeker, _ := file.(io.ReadSeeker); fileSeeker != nil {
 		if _, err := fileSeeker.Seek(0, io.SeekStart); err != nil {
 			return fmt.Errorf("seeker can't seek")
 		}
 
-		http.ServeContent(rw, req, statInfo.Name(), statInfo.ModTime(), fileSeeker)
+		http.ServeContent(rw, req, name, statInfo.ModTime(), fileSeeker)
 		return nil
 	}
 
@@ -191,6 +236,50 @@ func (d *assetHandler) serveFSFile(rw http.ResponseWriter, req *http.Request, fi
 	return err
 }
 
+// setCacheControl sets the Cache-Control header of the first rule matching the request path,
+// unless the header has already been set, EG: when serving from disk
+func (d *assetHandler) setCacheControl(rw http.ResponseWriter, req *http.Request) {
+	if _, haveCacheControl := rw.Header()[HeaderCacheControl]; haveCacheControl {
+		return
+	}
+	for _, rule := range d.cacheControl {
+		if matchPath(rule.Pattern, req.URL.Path) {
+			rw.Header().Set(HeaderCacheControl, rule.Value)
+			return
+		}
+	}
+}
+
+// openPrecompressed opens the precompressed sibling of the file, EG: `main.js.br`, with the
+// most preferred encoding the request accepts. The response varies by the accepted encodings
+// if the file has any precompressed siblings.
+func (d *assetHandler) openPrecompressed(rw http.ResponseWriter, req *http.Request, filename string) (iofs.File, iofs.FileInfo, string) {
+	acceptEncoding := req.Header.Get(HeaderAcceptEncoding)
+	hasSiblings := false
+	for _, precompressed := range precompressedEncodings {
+		file, err := d.fs.Open(filename + precompressed.extension)
+		if err != nil {
+			continue
+		}
+		statInfo, err := file.Stat()
+		if err != nil || statInfo.IsDir() {
+			file.Close()
+			continue
+		}
+		hasSiblings = true
+		if !acceptsEncoding(acceptEncoding, precompressed.encoding) {
+			file.Close()
+			continue
+		}
+		rw.Header().Add(HeaderVary, HeaderAcceptEncoding)
+		return file, statInfo, precompressed.encoding
+	}
+	if hasSiblings {
+		rw.Header().Add(HeaderVary, HeaderAcceptEncoding)
+	}
+	return nil, nil, ""
+}
+
 func (d *assetHandler) logDebug(message string, args ...interface{}) {
 	if d.logger != nil {
 		d.logger.Debug("[AssetHandler] "+message, args...)
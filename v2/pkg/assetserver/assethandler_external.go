@@ -10,6 +10,7 @@ import (
 	"net/http/httputil"
 	"net/url"
 
+	"github.com/wailsapp/wails/v2/internal/frontend"
 	"github.com/wailsapp/wails/v2/pkg/options/assetserver"
 )
 
@@ -22,6 +23,7 @@ func NewExternalAssetsHandler(logger Logger, options assetserver.Options, url *u
 	baseDirector := proxy.Director
 	proxy.Director = func(r *http.Request) {
 		baseDirector(r)
+		setServedFrom(r, frontend.ServedFromProxy)
 		if logger != nil {
 			logger.Debug("[ExternalAssetHandler] Loading '%s'", r.URL)
 		}
@@ -48,6 +50,7 @@ func NewExternalAssetsHandler(logger Logger, options assetserver.Options, url *u
 			if logger != nil {
 				logger.Debug("[ExternalAssetHandler] '%s' returned not found, using AssetHandler", r.URL)
 			}
+			setServedFrom(r, frontend.ServedFromHandler)
 			baseHandler.ServeHTTP(rw, r)
 		} else {
 			if logger != nil {
@@ -57,14 +60,26 @@ func NewExternalAssetsHandler(logger Logger, options assetserver.Options, url *u
 		}
 	}
 
+	// FileRoutes and signed file URLs are served from disk, they are not part of the frontend dev server
+	fileRoutes := &assetHandler{
+		fileRoutes:     options.FileRoutes,
+		signedFileURLs: options.SignedFileURLs,
+		logger:         logger,
+	}
+
 	var result http.Handler = http.HandlerFunc(
 		func(rw http.ResponseWriter, req *http.Request) {
+			if fileRoutes.serveFileRoute(rw, req) {
+				return
+			}
+
 			if req.Method == http.MethodGet {
 				proxy.ServeHTTP(rw, req)
 				return
 			}
 
 			if baseHandler != nil {
+				setServedFrom(req, frontend.ServedFromHandler)
 				baseHandler.ServeHTTP(rw, req)
 				return
 			}
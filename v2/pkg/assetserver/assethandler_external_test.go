@@ -0,0 +1,55 @@
+//go:build dev
+// +build dev
+
+package assetserver
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/matryer/is"
+	"github.com/wailsapp/wails/v2/pkg/options/assetserver"
+)
+
+func TestExternalAssetsHandler_FileRoutes(t *testing.T) {
+	is2 := is.New(t)
+
+	userFiles := t.TempDir()
+	is2.NoErr(os.WriteFile(filepath.Join(userFiles, "photo.png"), []byte("\x89PNG\r\n\x1a\nphoto"), 0644))
+
+	devServer := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		_, _ = rw.Write([]byte("dev server"))
+	}))
+	defer devServer.Close()
+	devServerURL, err := url.Parse(devServer.URL)
+	is2.NoErr(err)
+
+	options := assetserver.Options{
+		FileRoutes: []assetserver.FileRoute{
+			{Prefix: "/userfiles/", Dir: userFiles},
+		},
+	}
+	handler := NewExternalAssetsHandler(nil, options, devServerURL)
+
+	rw := httptest.NewRecorder()
+	handler.ServeHTTP(rw, httptest.NewRequest(http.MethodGet, "/userfiles/photo.png", nil))
+	is2.Equal(rw.Code, http.StatusOK)
+	is2.Equal(rw.Body.String(), "\x89PNG\r\n\x1a\nphoto")
+
+	rw = httptest.NewRecorder()
+	handler.ServeHTTP(rw, httptest.NewRequest(http.MethodGet, "/userfiles/missing.png", nil))
+	is2.Equal(rw.Code, http.StatusNotFound)
+
+	rw = httptest.NewRecorder()
+	handler.ServeHTTP(rw, httptest.NewRequest(http.MethodGet, "/src/main.js", nil))
+	is2.Equal(rw.Body.String(), "dev server")
+
+	// Signed file URLs are not enabled, so their paths are served by the dev server
+	rw = httptest.NewRecorder()
+	handler.ServeHTTP(rw, httptest.NewRequest(http.MethodGet, "/wails/file/token/photo.png", nil))
+	is2.Equal(rw.Body.String(), "dev server")
+}
@@ -0,0 +1,91 @@
+package assetserver
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"github.com/matryer/is"
+	"github.com/wailsapp/wails/v2/pkg/options/assetserver"
+)
+
+func TestAssetHandler_Precompressed(t *testing.T) {
+	assets := fstest.MapFS{
+		"index.html":  {Data: []byte("<html></html>")},
+		"main.js":     {Data: []byte("console.log('raw')")},
+		"main.js.br":  {Data: []byte("brotli")},
+		"main.js.gz":  {Data: []byte("gzip")},
+		"main.css":    {Data: []byte("body{}")},
+		"main.css.gz": {Data: []byte("gzip")},
+	}
+	handler, err := NewAssetHandler(assetserver.Options{Assets: assets}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name           string
+		path           string
+		acceptEncoding string
+		wantBody       string
+		wantEncoding   string
+		wantVary       bool
+	}{
+		{"no accept encoding", "/main.js", "", "console.log('raw')", "", true},
+		{"prefers brotli", "/main.js", "gzip, deflate, br", "brotli", "br", true},
+		{"gzip only", "/main.js", "gzip", "gzip", "gzip", true},
+		{"brotli refused", "/main.js", "br;q=0, gzip;q=0.5", "gzip", "gzip", true},
+		{"wildcard", "/main.js", "*", "brotli", "br", true},
+		{"wildcard with refused brotli", "/main.js", "br;q=0, *", "gzip", "gzip", true},
+		{"only gzip sibling", "/main.css", "br, gzip", "gzip", "gzip", true},
+		{"no siblings", "/index.html", "br, gzip", "<html></html>", "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			is2 := is.New(t)
+
+			req := httptest.NewRequest(http.MethodGet, tt.path, nil)
+			if tt.acceptEncoding != "" {
+				req.Header.Set(HeaderAcceptEncoding, tt.acceptEncoding)
+			}
+			rw := httptest.NewRecorder()
+			handler.ServeHTTP(rw, req)
+
+			is2.Equal(rw.Code, http.StatusOK)
+			is2.Equal(rw.Body.String(), tt.wantBody)
+			is2.Equal(rw.Header().Get(HeaderContentEncoding), tt.wantEncoding)
+			is2.Equal(rw.Header().Get(HeaderVary) == HeaderAcceptEncoding, tt.wantVary)
+			if tt.path == "/main.js" {
+				is2.Equal(rw.Header().Get(HeaderContentType), "text/javascript; charset=utf-8")
+			}
+		})
+	}
+}
+
+func TestAssetServer_IndexNotPrecompressed(t *testing.T) {
+	is2 := is.New(t)
+
+	assets := fstest.MapFS{
+		"index.html":    {Data: []byte("<html><head></head><body></body></html>")},
+		"index.html.gz": {Data: []byte("gzip")},
+	}
+	server, err := NewAssetServer("", assetserver.Options{Assets: assets}, false, nil, testRuntimeAssets{})
+	is2.NoErr(err)
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set(HeaderAcceptEncoding, "gzip")
+	rw := httptest.NewRecorder()
+	server.ServeHTTP(rw, req)
+
+	is2.Equal(rw.Code, http.StatusOK)
+	is2.Equal(rw.Header().Get(HeaderContentEncoding), "")
+	is2.True(strings.Contains(rw.Body.String(), `<script src="/wails/runtime.js"></script>`))
+}
+
+type testRuntimeAssets struct{}
+
+func (testRuntimeAssets) DesktopIPC() []byte       { return []byte("ipc") }
+func (testRuntimeAssets) WebsocketIPC() []byte     { return []byte("websocket") }
+func (testRuntimeAssets) RuntimeDesktopJS() []byte { return []byte("runtime") }
@@ -7,9 +7,13 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"strings"
+	"time"
 
 	"golang.org/x/net/html"
 
+	"github.com/wailsapp/wails/v2/internal/frontend"
+	"github.com/wailsapp/wails/v2/internal/logger"
+	pkgLogger "github.com/wailsapp/wails/v2/pkg/logger"
 	"github.com/wailsapp/wails/v2/pkg/options"
 	"github.com/wailsapp/wails/v2/pkg/options/assetserver"
 )
@@ -35,18 +39,25 @@ type AssetServer struct {
 	runtimeJS []byte
 	ipcJS     func(*http.Request) []byte
 
-	logger  Logger
-	runtime RuntimeAssets
+	logger     Logger
+	structured *logger.Structured
+	runtime    RuntimeAssets
 
 	servingFromDisk     bool
 	appendSpinnerToBody bool
 
+	// Serve the statistics of the RequestInspector
+	serveInspector bool
+
 	// Use http based runtime
 	runtimeHandler RuntimeHandler
 
 	// plugin scripts
 	pluginScripts map[string]string
 
+	securityHeaders *assetserver.SecurityHeaders
+	spaFallback     *assetserver.SPAFallback
+
 	assetServerWebView
 }
 
@@ -64,7 +75,13 @@ func NewAssetServer(bindingsJSON string, options assetserver.Options, servingFro
 		return nil, err
 	}
 
-	return NewAssetServerWithHandler(handler, bindingsJSON, servingFromDisk, logger, runtime)
+	result, err := NewAssetServerWithHandler(handler, bindingsJSON, servingFromDisk, logger, runtime)
+	if err != nil {
+		return nil, err
+	}
+	result.UseSecurityHeaders(options.SecurityHeaders)
+	result.UseSPAFallback(options.SPAFallback)
+	return result, nil
 }
 
 func NewAssetServerWithHandler(handler http.Handler, bindingsJSON string, servingFromDisk bool, logger Logger, runtime RuntimeAssets) (*AssetServer, error) {
@@ -87,6 +104,10 @@ func NewAssetServerWithHandler(handler http.Handler, bindingsJSON string, servin
 		runtime:         runtime,
 	}
 
+	if structured, ok := logger.(StructuredLogger); ok {
+		result.structured = structured.Structured().With("component", "AssetServer")
+	}
+
 	return result, nil
 }
 
@@ -94,6 +115,11 @@ func (d *AssetServer) UseRuntimeHandler(handler RuntimeHandler) {
 	d.runtimeHandler = handler
 }
 
+// UseSecurityHeaders sets the security headers added to every response
+func (d *AssetServer) UseSecurityHeaders(headers *assetserver.SecurityHeaders) {
+	d.securityHeaders = headers
+}
+
 func (d *AssetServer) AddPluginScript(pluginName string, script string) {
 	if d.pluginScripts == nil {
 		d.pluginScripts = make(map[string]string)
@@ -105,44 +131,66 @@ func (d *AssetServer) AddPluginScript(pluginName string, script string) {
 }
 
 func (d *AssetServer) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
+	traceEnabled := d.structured != nil && d.structured.Enabled(req.Context(), pkgLogger.TRACE)
+	if !traceEnabled && requestInspector == nil {
+		d.serveHTTP(rw, req)
+		return
+	}
+
+	start := time.Now()
+	req, servedFrom := withServedFrom(req)
+	recorder := &statusRecorder{ResponseWriter: rw, status: http.StatusOK}
+	d.serveHTTP(recorder, req)
+	duration := time.Since(start)
+
+	if traceEnabled {
+		d.structured.Log(req.Context(), pkgLogger.TRACE, "request",
+			"method", req.Method,
+			"path", req.URL.Path,
+			"status", recorder.status,
+			"bytes", recorder.bytes,
+			"servedFrom", *servedFrom,
+			"duration", duration)
+	}
+	if req.URL.Path != inspectorPath {
+		requestInspector.record(frontend.AssetRequest{
+			Time:       start,
+			Method:     req.Method,
+			Path:       req.URL.Path,
+			Status:     recorder.status,
+			Bytes:      recorder.bytes,
+			Duration:   duration,
+			ServedFrom: *servedFrom,
+		})
+	}
+}
+
+func (d *AssetServer) serveHTTP(rw http.ResponseWriter, req *http.Request) {
 	if isWebSocket(req) {
 		// WebSockets are not supported by the AssetServer
 		rw.WriteHeader(http.StatusNotImplemented)
 		return
 	}
 
-	header := rw.Header()
 	if d.servingFromDisk {
-		header.Add(HeaderCacheControl, "no-cache")
+		// Handlers may replace the headers with their own, e.g. the CacheControl rules, so
+		// no-cache is enforced when the response is written
+		rw = &noCacheWriter{ResponseWriter: rw}
 	}
+	setSecurityHeaders(rw.Header(), d.securityHeaders, "")
 
 	path := req.URL.Path
+	if d.serveInspector && path == inspectorPath {
+		d.serveInspectorStats(rw)
+		return
+	}
+
 	switch path {
 	case "", "/", "/index.html":
-		recorder := httptest.NewRecorder()
-		d.handler.ServeHTTP(recorder, req)
-		for k, v := range recorder.HeaderMap {
-			header[k] = v
-		}
-
-		switch recorder.Code {
-		case http.StatusOK:
-			content, err := d.processIndexHTML(recorder.Body.Bytes())
-			if err != nil {
-				d.serveError(rw, err, "Unable to processIndexHTML")
-				return
-			}
-			d.writeBlob(rw, indexHTML, content)
-
-		case http.StatusNotFound:
-			d.writeBlob(rw, indexHTML, defaultHTML)
-
-		default:
-			rw.WriteHeader(recorder.Code)
-
-		}
+		d.serveIndex(rw, req)
 
 	case runtimeJSPath:
+		setServedFrom(req, frontend.ServedFromRuntime)
 		d.writeBlob(rw, path, d.runtimeJS)
 
 	case runtimePath:
@@ -153,6 +201,7 @@ func (d *AssetServer) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
 		}
 
 	case ipcJSPath:
+		setServedFrom(req, frontend.ServedFromRuntime)
 		content := d.runtime.DesktopIPC()
 		if d.ipcJS != nil {
 			content = d.ipcJS(req)
@@ -162,14 +211,61 @@ func (d *AssetServer) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
 	default:
 		// Check if this is a plugin script
 		if script, ok := d.pluginScripts[path]; ok {
+			setServedFrom(req, frontend.ServedFromRuntime)
 			d.writeBlob(rw, path, []byte(script))
 			return
 		}
+		if d.isSPANavigation(req) {
+			d.serveSPANavigation(rw, req)
+			return
+		}
 		d.handler.ServeHTTP(rw, req)
 	}
 }
 
-func (d *AssetServer) processIndexHTML(indexHTML []byte) ([]byte, error) {
+// serveIndex serves the page of the request with the runtime scripts injected
+func (d *AssetServer) serveIndex(rw http.ResponseWriter, req *http.Request) {
+	// The page is processed before it is served, so it must not be served precompressed or
+	// revalidated against the unprocessed page
+	indexReq := req.Clone(req.Context())
+	indexReq.Header.Del(HeaderAcceptEncoding)
+	indexReq.Header.Del(HeaderIfNoneMatch)
+	indexReq.Header.Del("If-Modified-Since")
+
+	recorder := httptest.NewRecorder()
+	d.handler.ServeHTTP(recorder, indexReq)
+
+	header := rw.Header()
+	for k, v := range recorder.HeaderMap {
+		header[k] = v
+	}
+	header.Del(HeaderETag)
+
+	switch recorder.Code {
+	case http.StatusOK:
+		nonce, err := d.scriptNonce()
+		if err != nil {
+			d.serveError(rw, err, "Unable to create script nonce")
+			return
+		}
+		content, err := d.processIndexHTML(recorder.Body.Bytes(), nonce)
+		if err != nil {
+			d.serveError(rw, err, "Unable to processIndexHTML")
+			return
+		}
+		setSecurityHeaders(header, d.securityHeaders, nonce)
+		d.writeBlob(rw, indexHTML, content)
+
+	case http.StatusNotFound:
+		d.writeBlob(rw, indexHTML, defaultHTML)
+
+	default:
+		rw.WriteHeader(recorder.Code)
+
+	}
+}
+
+func (d *AssetServer) processIndexHTML(indexHTML []byte, nonce string) ([]byte, error) {
 	htmlNode, err := getHTMLNode(indexHTML)
 	if err != nil {
 		return nil, err
@@ -182,17 +278,17 @@ func (d *AssetServer) processIndexHTML(indexHTML []byte) ([]byte, error) {
 		}
 	}
 
-	if err := insertScriptInHead(htmlNode, runtimeJSPath); err != nil {
+	if err := insertScriptInHead(htmlNode, runtimeJSPath, nonce); err != nil {
 		return nil, err
 	}
 
-	if err := insertScriptInHead(htmlNode, ipcJSPath); err != nil {
+	if err := insertScriptInHead(htmlNode, ipcJSPath, nonce); err != nil {
 		return nil, err
 	}
 
 	// Inject plugins
 	for scriptName := range d.pluginScripts {
-		if err := insertScriptInHead(htmlNode, scriptName); err != nil {
+		if err := insertScriptInHead(htmlNode, scriptName, nonce); err != nil {
 			return nil, err
 		}
 	}
@@ -229,3 +325,59 @@ func (d *AssetServer) logError(message string, args ...interface{}) {
 		d.logger.Error("[AssetServer] "+message, args...)
 	}
 }
+
+// statusRecorder records the status code written to a ResponseWriter
+type statusRecorder struct {
+	http.ResponseWriter
+	status      int
+	bytes       int64
+	wroteHeader bool
+}
+
+func (r *statusRecorder) WriteHeader(status int) {
+	if !r.wroteHeader {
+		r.status = status
+		r.wroteHeader = true
+	}
+	r.ResponseWriter.WriteHeader(status)
+}
+
+func (r *statusRecorder) Write(data []byte) (int, error) {
+	r.wroteHeader = true
+	n, err := r.ResponseWriter.Write(data)
+	r.bytes += int64(n)
+	return n, err
+}
+
+func (r *statusRecorder) Flush() {
+	if flusher, ok := r.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+// noCacheWriter makes sure every response served from disk is revalidated by the webview
+type noCacheWriter struct {
+	http.ResponseWriter
+	wroteHeader bool
+}
+
+func (w *noCacheWriter) WriteHeader(status int) {
+	if !w.wroteHeader {
+		w.Header().Set(HeaderCacheControl, "no-cache")
+		w.wroteHeader = true
+	}
+	w.ResponseWriter.WriteHeader(status)
+}
+
+func (w *noCacheWriter) Write(data []byte) (int, error) {
+	if !w.wroteHeader {
+		w.WriteHeader(http.StatusOK)
+	}
+	return w.ResponseWriter.Write(data)
+}
+
+func (w *noCacheWriter) Flush() {
+	if flusher, ok := w.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
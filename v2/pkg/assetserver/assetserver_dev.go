@@ -20,6 +20,7 @@ func NewDevAssetServer(handler http.Handler, bindingsJSON string, servingFromDis
 	}
 
 	result.appendSpinnerToBody = true
+	result.serveInspector = true
 	result.ipcJS = func(req *http.Request) []byte {
 		if strings.Contains(req.UserAgent(), WailsUserAgentValue) {
 			return runtime.DesktopIPC()
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"path"
 	"strings"
 
 	"github.com/wailsapp/wails/v2/pkg/options"
@@ -39,6 +40,14 @@ const (
 	HeaderCacheControl  = "Cache-Control"
 	HeaderUpgrade       = "Upgrade"
 
+	HeaderAcceptEncoding  = "Accept-Encoding"
+	HeaderContentEncoding = "Content-Encoding"
+	HeaderVary            = "Vary"
+
+	HeaderContentSecurityPolicy = "Content-Security-Policy"
+	HeaderPermissionsPolicy     = "Permissions-Policy"
+	HeaderReferrerPolicy        = "Referrer-Policy"
+
 	WailsUserAgentValue = "wails.io"
 )
 
@@ -55,8 +64,8 @@ func serveFile(rw http.ResponseWriter, filename string, blob []byte) error {
 	return err
 }
 
-func createScriptNode(scriptName string) *html.Node {
-	return &html.Node{
+func createScriptNode(scriptName string, nonce string) *html.Node {
+	result := &html.Node{
 		Type: html.ElementNode,
 		Data: "script",
 		Attr: []html.Attribute{
@@ -66,6 +75,10 @@ func createScriptNode(scriptName string) *html.Node {
 			},
 		},
 	}
+	if nonce != "" {
+		result.Attr = append(result.Attr, html.Attribute{Key: "nonce", Val: nonce})
+	}
+	return result
 }
 
 func createDivNode(id string) *html.Node {
@@ -82,12 +95,12 @@ func createDivNode(id string) *html.Node {
 	}
 }
 
-func insertScriptInHead(htmlNode *html.Node, scriptName string) error {
+func insertScriptInHead(htmlNode *html.Node, scriptName string, nonce string) error {
 	headNode := findFirstTag(htmlNode, "head")
 	if headNode == nil {
 		return errors.New("cannot find head in HTML")
 	}
-	scriptNode := createScriptNode(scriptName)
+	scriptNode := createScriptNode(scriptName, nonce)
 	if headNode.FirstChild != nil {
 		headNode.InsertBefore(scriptNode, headNode.FirstChild)
 	} else {
@@ -133,3 +146,15 @@ func isWebSocket(req *http.Request) bool {
 	upgrade := req.Header.Get(HeaderUpgrade)
 	return strings.EqualFold(upgrade, "websocket")
 }
+
+// matchPath returns true if the request path matches the `path.Match` pattern. A pattern ending
+// in "/*" also matches all paths below that directory.
+func matchPath(pattern string, requestPath string) bool {
+	if matched, _ := path.Match(pattern, requestPath); matched {
+		return true
+	}
+	if strings.HasSuffix(pattern, "/*") {
+		return strings.HasPrefix(requestPath, strings.TrimSuffix(pattern, "*"))
+	}
+	return false
+}
@@ -0,0 +1,96 @@
+package assetserver
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"io"
+	iofs "io/fs"
+	"net/http"
+	"strings"
+	"sync"
+)
+
+const (
+	HeaderETag        = "ETag"
+	HeaderIfNoneMatch = "If-None-Match"
+)
+
+// etagCache caches the content hash ETags of the files of an fs.FS. Only files without a
+// modification time are cached, as their content can't change, EG: files of an embed.FS
+type etagCache struct {
+	lock  sync.Mutex
+	etags map[string]*etagEntry
+}
+
+// etagEntry computes the ETag of a file once. Requests for the same file wait for it,
+// while requests for other files are not blocked.
+type etagEntry struct {
+	once sync.Once
+	etag string
+	err  error
+}
+
+// get returns the ETag of the file, computing it the first time the file is requested
+func (c *etagCache) get(fsys iofs.FS, filename string) (string, error) {
+	c.lock.Lock()
+	if c.etags == nil {
+		c.etags = make(map[string]*etagEntry)
+	}
+	entry := c.etags[filename]
+	if entry == nil {
+		entry = &etagEntry{}
+		c.etags[filename] = entry
+	}
+	c.lock.Unlock()
+
+	entry.once.Do(func() {
+		entry.etag, entry.err = hashFile(fsys, filename)
+	})
+	if entry.err != nil {
+		// Compute the ETag again on the next request
+		c.lock.Lock()
+		if c.etags[filename] == entry {
+			delete(c.etags, filename)
+		}
+		c.lock.Unlock()
+	}
+	return entry.etag, entry.err
+}
+
+func hashFile(fsys iofs.FS, filename string) (string, error) {
+	file, err := fsys.Open(filename)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	hash := sha256.New()
+	if _, err := io.Copy(hash, file); err != nil {
+		return "", err
+	}
+	return `"` + base64.RawURLEncoding.EncodeToString(hash.Sum(nil)[:16]) + `"`, nil
+}
+
+// etagMatches returns true if the If-None-Match header of the request matches the ETag
+func etagMatches(req *http.Request, etag string) bool {
+	ifNoneMatch := req.Header.Get(HeaderIfNoneMatch)
+	if ifNoneMatch == "" {
+		return false
+	}
+	for _, candidate := range strings.Split(ifNoneMatch, ",") {
+		candidate = strings.TrimPrefix(strings.TrimSpace(candidate), "W/")
+		if candidate == "*" || candidate == etag {
+			return true
+		}
+	}
+	return false
+}
+
+// writeNotModified writes a 304 response, removing the headers that describe the content
+func writeNotModified(rw http.ResponseWriter) {
+	header := rw.Header()
+	header.Del(HeaderContentType)
+	header.Del(HeaderContentLength)
+	header.Del(HeaderContentEncoding)
+	rw.WriteHeader(http.StatusNotModified)
+}
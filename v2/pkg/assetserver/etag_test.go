@@ -0,0 +1,164 @@
+package assetserver
+
+import (
+	iofs "io/fs"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"testing/fstest"
+	"time"
+
+	"github.com/matryer/is"
+	"github.com/wailsapp/wails/v2/pkg/options/assetserver"
+)
+
+func TestAssetHandler_ETag(t *testing.T) {
+	is2 := is.New(t)
+
+	assets := fstest.MapFS{
+		"index.html":               {Data: []byte("<html></html>")},
+		"assets/index-a1b2c.js":    {Data: []byte("console.log('raw')")},
+		"assets/index-a1b2c.js.gz": {Data: []byte("gzip")},
+		"disk.js":                  {Data: []byte("disk"), ModTime: time.Now()},
+	}
+	handler, err := NewAssetHandler(assetserver.Options{
+		Assets: assets,
+		CacheControl: []assetserver.CacheControlRule{
+			{Pattern: "/assets/*", Value: "public, max-age=31536000, immutable"},
+			{Pattern: "/*", Value: "no-cache"},
+		},
+	}, nil)
+	is2.NoErr(err)
+
+	serve := func(path string, headers map[string]string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		for key, value := range headers {
+			req.Header.Set(key, value)
+		}
+		rw := httptest.NewRecorder()
+		handler.ServeHTTP(rw, req)
+		return rw
+	}
+
+	rw := serve("/assets/index-a1b2c.js", nil)
+	is2.Equal(rw.Code, http.StatusOK)
+	etag := rw.Header().Get(HeaderETag)
+	is2.True(etag != "")
+	is2.Equal(rw.Header().Get(HeaderCacheControl), "public, max-age=31536000, immutable")
+
+	// The ETag is stable and revalidation results in a 304 without content
+	rw = serve("/assets/index-a1b2c.js", map[string]string{HeaderIfNoneMatch: etag})
+	is2.Equal(rw.Code, http.StatusNotModified)
+	is2.Equal(rw.Body.Len(), 0)
+	is2.Equal(rw.Header().Get(HeaderETag), etag)
+
+	// Precompressed content has its own ETag
+	rw = serve("/assets/index-a1b2c.js", map[string]string{HeaderAcceptEncoding: "gzip"})
+	is2.Equal(rw.Code, http.StatusOK)
+	is2.True(rw.Header().Get(HeaderETag) != etag)
+	rw = serve("/assets/index-a1b2c.js", map[string]string{HeaderAcceptEncoding: "gzip", HeaderIfNoneMatch: etag})
+	is2.Equal(rw.Code, http.StatusOK)
+
+	// Files with a modification time are revalidated using it
+	rw = serve("/disk.js", nil)
+	is2.Equal(rw.Code, http.StatusOK)
+	is2.Equal(rw.Header().Get(HeaderETag), "")
+	is2.True(rw.Header().Get("Last-Modified") != "")
+	is2.Equal(rw.Header().Get(HeaderCacheControl), "no-cache")
+}
+
+func TestAssetServer_ServingFromDiskNotCached(t *testing.T) {
+	is2 := is.New(t)
+
+	assets := fstest.MapFS{
+		"index.html": {Data: []byte("<html><head></head><body></body></html>")},
+		"main.js":    {Data: []byte("console.log('wails')")},
+	}
+	server, err := NewAssetServer("", assetserver.Options{
+		Assets:       assets,
+		CacheControl: []assetserver.CacheControlRule{{Pattern: "/*", Value: "public, max-age=31536000, immutable"}},
+		SPAFallback:  &assetserver.SPAFallback{},
+	}, true, nil, testRuntimeAssets{})
+	is2.NoErr(err)
+
+	serve := func(path string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		req.Header.Set("Accept", "text/html")
+		rw := httptest.NewRecorder()
+		server.ServeHTTP(rw, req)
+		return rw
+	}
+
+	rw := serve("/main.js")
+	is2.Equal(rw.Header().Values(HeaderCacheControl), []string{"no-cache"})
+
+	// The processed index.html has no ETag as it differs from the asset
+	rw = serve("/")
+	is2.Equal(rw.Code, http.StatusOK)
+	is2.Equal(rw.Header().Get(HeaderETag), "")
+	is2.Equal(rw.Header().Values(HeaderCacheControl), []string{"no-cache"})
+
+	// The SPA fallback serves the index.html with the headers of the asset handler
+	rw = serve("/settings/profile")
+	is2.Equal(rw.Code, http.StatusOK)
+	is2.Equal(rw.Header().Values(HeaderCacheControl), []string{"no-cache"})
+}
+
+// blockingFS blocks opening the given file until unblock is closed
+type blockingFS struct {
+	fstest.MapFS
+	blocked string
+	opening chan struct{}
+	unblock chan struct{}
+}
+
+func (f *blockingFS) Open(name string) (iofs.File, error) {
+	if name == f.blocked {
+		close(f.opening)
+		<-f.unblock
+	}
+	return f.MapFS.Open(name)
+}
+
+func TestETagCache_HashesConcurrently(t *testing.T) {
+	is2 := is.New(t)
+
+	fsys := &blockingFS{
+		MapFS:   fstest.MapFS{"large.js": {Data: []byte("large")}, "small.js": {Data: []byte("small")}},
+		blocked: "large.js",
+		opening: make(chan struct{}),
+		unblock: make(chan struct{}),
+	}
+	var cache etagCache
+
+	large := make(chan string)
+	go func() {
+		etag, _ := cache.get(fsys, "large.js")
+		large <- etag
+	}()
+	<-fsys.opening
+
+	// Hashing a file doesn't block requests for other files
+	small, err := cache.get(fsys, "small.js")
+	is2.NoErr(err)
+	is2.True(small != "")
+
+	close(fsys.unblock)
+	largeETag := <-large
+	is2.True(largeETag != "")
+	is2.True(largeETag != small)
+}
+
+func TestETagCache_RetriesErrors(t *testing.T) {
+	is2 := is.New(t)
+
+	fsys := fstest.MapFS{}
+	var cache etagCache
+	_, err := cache.get(fsys, "late.js")
+	is2.True(err != nil)
+
+	fsys["late.js"] = &fstest.MapFile{Data: []byte("late")}
+	etag, err := cache.get(fsys, "late.js")
+	is2.NoErr(err)
+	is2.True(etag != "")
+}
@@ -0,0 +1,184 @@
+package assetserver
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	iofs "io/fs"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/wailsapp/wails/v2/internal/frontend"
+	"github.com/wailsapp/wails/v2/internal/signedurl"
+	"github.com/wailsapp/wails/v2/pkg/options/assetserver"
+)
+
+const HeaderContentTypeOptions = "X-Content-Type-Options"
+
+var errOutsideRoot = errors.New("path is outside of the root")
+
+// serveFileRoute serves the request if it matches a FileRoute, or is a signed file URL and these are enabled
+func (d *assetHandler) serveFileRoute(rw http.ResponseWriter, req *http.Request) bool {
+	url := req.URL.Path
+	if d.signedFileURLs && strings.HasPrefix(url, signedurl.Path) {
+		d.serveRouteRequest(rw, req, func() (iofs.File, string, error) {
+			filename, err := signedurl.Verify(url)
+			if err != nil {
+				return nil, "", os.ErrNotExist
+			}
+			file, err := os.Open(filename)
+			return file, filepath.Base(filename), err
+		})
+		return true
+	}
+
+	for _, route := range d.fileRoutes {
+		if !strings.HasPrefix(url, route.Prefix) {
+			continue
+		}
+		d.serveRouteRequest(rw, req, func() (iofs.File, string, error) {
+			return openRouteFile(route, strings.TrimPrefix(url, route.Prefix))
+		})
+		return true
+	}
+	return false
+}
+
+func (d *assetHandler) serveRouteRequest(rw http.ResponseWriter, req *http.Request, open func() (iofs.File, string, error)) {
+	if req.Method != http.MethodGet && req.Method != http.MethodHead {
+		rw.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	setServedFrom(req, frontend.ServedFromFileRoute)
+
+	file, name, err := open()
+	if err == nil {
+		defer file.Close()
+		err = serveRouteFile(rw, req, file, name)
+	}
+	if err != nil {
+		if errors.Is(err, iofs.ErrNotExist) || errors.Is(err, iofs.ErrPermission) || errors.Is(err, errOutsideRoot) {
+			d.logDebug("File '%s' not found: %s", req.URL.Path, err)
+			rw.WriteHeader(http.StatusNotFound)
+			return
+		}
+		d.logError("Unable to handle request '%s': %s", req.URL.Path, err)
+		http.Error(rw, err.Error(), http.StatusInternalServerError)
+	}
+}
+
+// openRouteFile opens the file of the route at the path relative to the root of the route
+func openRouteFile(route assetserver.FileRoute, name string) (iofs.File, string, error) {
+	name, err := cleanRoutePath(name)
+	if err != nil {
+		return nil, "", err
+	}
+	if !routeAllows(route, name) {
+		return nil, "", fmt.Errorf("%w: '%s' is not allowed", iofs.ErrNotExist, name)
+	}
+
+	if route.Root != nil {
+		file, err := route.Root.Open(name)
+		return file, path.Base(name), err
+	}
+
+	filename := filepath.Join(route.Dir, filepath.FromSlash(name))
+	if !route.FollowSymlinks {
+		if filename, err = resolveInRoot(route.Dir, filename); err != nil {
+			return nil, "", err
+		}
+	}
+	file, err := os.Open(filename)
+	return file, path.Base(name), err
+}
+
+// cleanRoutePath validates the request path relative to the root of a route. Paths with `..`
+// segments, backslashes or NUL characters are rejected instead of being cleaned, as they are
+// attempts to escape the root.
+func cleanRoutePath(name string) (string, error) {
+	if strings.ContainsAny(name, "\\\x00") {
+		return "", errOutsideRoot
+	}
+	for _, segment := range strings.Split(name, "/") {
+		if segment == ".." {
+			return "", errOutsideRoot
+		}
+	}
+	name = path.Clean("/" + name)[1:]
+	if name == "" || !iofs.ValidPath(name) {
+		return "", iofs.ErrNotExist
+	}
+	return name, nil
+}
+
+func routeAllows(route assetserver.FileRoute, name string) bool {
+	if len(route.AllowList) == 0 {
+		return true
+	}
+	for _, pattern := range route.AllowList {
+		if matchPath(pattern, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// resolveInRoot resolves the symlinks of the file and checks that it is inside the root directory
+func resolveInRoot(root string, filename string) (string, error) {
+	resolvedRoot, err := filepath.EvalSymlinks(root)
+	if err != nil {
+		return "", err
+	}
+	resolved, err := filepath.EvalSymlinks(filename)
+	if err != nil {
+		return "", err
+	}
+	relative, err := filepath.Rel(resolvedRoot, resolved)
+	if err != nil || relative == ".." || strings.HasPrefix(relative, ".."+string(filepath.Separator)) {
+		return "", errOutsideRoot
+	}
+	return resolved, nil
+}
+
+// serveRouteFile serves a file of a route. Range requests are supported for files that can seek, which
+// allows media to be streamed.
+func serveRouteFile(rw http.ResponseWriter, req *http.Request, file iofs.File, name string) error {
+	statInfo, err := file.Stat()
+	if err != nil {
+		return err
+	}
+	if statInfo.IsDir() {
+		return iofs.ErrNotExist
+	}
+
+	var buf [512]byte
+	n, err := file.Read(buf[:])
+	if err != nil && err != io.EOF {
+		return err
+	}
+	header := rw.Header()
+	header.Set(HeaderContentType, GetMimetype(name, buf[:n]))
+	// User content must not be interpreted as another type, EG: an image as HTML
+	header.Set(HeaderContentTypeOptions, "nosniff")
+
+	if fileSeeker, _ := file.(io.ReadSeeker); fileSeeker != nil {
+		if _, err := fileSeeker.Seek(0, io.SeekStart); err != nil {
+			return fmt.Errorf("seeker can't seek")
+		}
+		http.ServeContent(rw, req, name, statInfo.ModTime(), fileSeeker)
+		return nil
+	}
+
+	header.Set(HeaderContentLength, fmt.Sprintf("%d", statInfo.Size()))
+	if req.Method == http.MethodHead {
+		return nil
+	}
+	if _, err := rw.Write(buf[:n]); err != nil {
+		return err
+	}
+	_, err = io.Copy(rw, file)
+	return err
+}
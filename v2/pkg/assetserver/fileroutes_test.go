@@ -0,0 +1,119 @@
+package assetserver
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"testing/fstest"
+	"time"
+
+	"github.com/matryer/is"
+	"github.com/wailsapp/wails/v2/internal/signedurl"
+	"github.com/wailsapp/wails/v2/pkg/options/assetserver"
+)
+
+func TestAssetHandler_FileRoutes(t *testing.T) {
+	is2 := is.New(t)
+
+	root := t.TempDir()
+	userFiles := filepath.Join(root, "userfiles")
+	is2.NoErr(os.MkdirAll(filepath.Join(userFiles, "album"), 0755))
+	is2.NoErr(os.WriteFile(filepath.Join(userFiles, "album", "photo.png"), []byte("\x89PNG\r\n\x1a\nphoto"), 0644))
+	is2.NoErr(os.WriteFile(filepath.Join(userFiles, "notes.txt"), []byte("notes"), 0644))
+	is2.NoErr(os.WriteFile(filepath.Join(root, "secret.png"), []byte("secret"), 0644))
+	is2.NoErr(os.Symlink(filepath.Join(root, "secret.png"), filepath.Join(userFiles, "link.png")))
+	is2.NoErr(os.Symlink(filepath.Join(userFiles, "album", "photo.png"), filepath.Join(userFiles, "inside.png")))
+
+	options := assetserver.Options{
+		Assets: fstest.MapFS{"index.html": {Data: []byte("<html></html>")}},
+		FileRoutes: []assetserver.FileRoute{
+			{Prefix: "/userfiles/", Dir: userFiles, AllowList: []string{"*.png", "album/*"}},
+			{Prefix: "/followed/", Dir: userFiles, FollowSymlinks: true},
+			{Prefix: "/fs/", Root: fstest.MapFS{"video.mp4": {Data: []byte("0123456789")}}},
+		},
+	}
+	is2.NoErr(options.Validate())
+	handler, err := NewAssetHandler(options, nil)
+	is2.NoErr(err)
+
+	serve := func(method string, url string, rangeHeader string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, url, nil)
+		if rangeHeader != "" {
+			req.Header.Set("Range", rangeHeader)
+		}
+		rw := httptest.NewRecorder()
+		handler.ServeHTTP(rw, req)
+		return rw
+	}
+
+	rw := serve(http.MethodGet, "/userfiles/album/photo.png", "")
+	is2.Equal(rw.Code, http.StatusOK)
+	is2.Equal(rw.Header().Get(HeaderContentType), "image/png")
+	is2.Equal(rw.Header().Get(HeaderContentTypeOptions), "nosniff")
+
+	tests := []struct {
+		name     string
+		url      string
+		wantCode int
+	}{
+		{"not allowed", "/userfiles/notes.txt", http.StatusNotFound},
+		{"traversal", "/userfiles/../secret.png", http.StatusNotFound},
+		{"encoded traversal", "/userfiles/album/%2e%2e/%2e%2e/secret.png", http.StatusNotFound},
+		{"backslash", "/userfiles/album\\..\\..\\secret.png", http.StatusNotFound},
+		{"directory", "/userfiles/album", http.StatusNotFound},
+		{"symlink outside root", "/userfiles/link.png", http.StatusNotFound},
+		{"symlink inside root", "/userfiles/inside.png", http.StatusOK},
+		{"followed symlink", "/followed/link.png", http.StatusOK},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			is2 := is.New(t)
+			rw := serve(http.MethodGet, tt.url, "")
+			is2.Equal(rw.Code, tt.wantCode)
+		})
+	}
+
+	// Media can be requested in ranges
+	rw = serve(http.MethodGet, "/fs/video.mp4", "bytes=2-5")
+	is2.Equal(rw.Code, http.StatusPartialContent)
+	is2.Equal(rw.Body.String(), "2345")
+
+	is2.Equal(serve(http.MethodPost, "/fs/video.mp4", "").Code, http.StatusMethodNotAllowed)
+
+	// Signed URLs are only served when enabled
+	signed, err := signedurl.Sign(filepath.Join(root, "secret.png"), time.Minute)
+	is2.NoErr(err)
+	rw = serve(http.MethodGet, signed, "")
+	is2.Equal(rw.Code, http.StatusNotFound)
+	is2.Equal(rw.Header().Get(HeaderContentTypeOptions), "")
+}
+
+func TestAssetHandler_SignedFileURLs(t *testing.T) {
+	is2 := is.New(t)
+
+	filename := filepath.Join(t.TempDir(), "secret.png")
+	is2.NoErr(os.WriteFile(filename, []byte("secret"), 0644))
+
+	handler, err := NewAssetHandler(assetserver.Options{
+		Assets:         fstest.MapFS{"index.html": {Data: []byte("<html></html>")}},
+		SignedFileURLs: true,
+	}, nil)
+	is2.NoErr(err)
+
+	serve := func(url string) *httptest.ResponseRecorder {
+		rw := httptest.NewRecorder()
+		handler.ServeHTTP(rw, httptest.NewRequest(http.MethodGet, url, nil))
+		return rw
+	}
+
+	// Files outside of the routes can be served with signed URLs
+	signed, err := signedurl.Sign(filename, time.Minute)
+	is2.NoErr(err)
+	rw := serve(signed)
+	is2.Equal(rw.Code, http.StatusOK)
+	is2.Equal(rw.Body.String(), "secret")
+	is2.Equal(serve(signedurl.Path+"0"+strings.TrimPrefix(signed, signedurl.Path)).Code, http.StatusNotFound)
+}
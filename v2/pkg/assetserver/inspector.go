@@ -0,0 +1,171 @@
+package assetserver
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/wailsapp/wails/v2/internal/frontend"
+)
+
+// inspectorPath serves the statistics of the RequestInspector as JSON in dev mode
+const inspectorPath = "/wails/debug/requests"
+
+const (
+	inspectorMaxRequests = 500
+	inspectorMaxPaths    = 1000
+
+	// inspectorOtherPaths collects the statistics of the paths after inspectorMaxPaths
+	inspectorOtherPaths = "(other)"
+)
+
+// latencyBounds are the upper bounds of the latency histogram buckets
+var latencyBounds = []time.Duration{
+	time.Millisecond,
+	5 * time.Millisecond,
+	10 * time.Millisecond,
+	25 * time.Millisecond,
+	50 * time.Millisecond,
+	100 * time.Millisecond,
+	250 * time.Millisecond,
+	500 * time.Millisecond,
+	time.Second,
+}
+
+// requestInspector records the requests of all AssetServers once enabled by EnableInspector
+var (
+	requestInspector     *RequestInspector
+	requestInspectorOnce sync.Once
+)
+
+// RequestInspector records the requests served by the AssetServers of the application in
+// dev and debug builds. A nil RequestInspector records nothing.
+type RequestInspector struct {
+	lock     sync.Mutex
+	requests *ringqueue[frontend.AssetRequest]
+	paths    map[string]*pathStats
+}
+
+type pathStats struct {
+	count   int
+	bytes   int64
+	buckets []int
+}
+
+func newRequestInspector() *RequestInspector {
+	return &RequestInspector{
+		requests: newRingqueue[frontend.AssetRequest](inspectorMaxRequests),
+		paths:    make(map[string]*pathStats),
+	}
+}
+
+// EnableInspector enables recording the requests served by the AssetServers and returns the
+// RequestInspector. It is called by dev and debug builds before the AssetServers are created.
+func EnableInspector() *RequestInspector {
+	requestInspectorOnce.Do(func() {
+		requestInspector = newRequestInspector()
+	})
+	return requestInspector
+}
+
+// Inspector returns the RequestInspector, or nil if it has not been enabled
+func Inspector() *RequestInspector {
+	return requestInspector
+}
+
+func (i *RequestInspector) record(request frontend.AssetRequest) {
+	if i == nil {
+		return
+	}
+	i.lock.Lock()
+	defer i.lock.Unlock()
+
+	i.requests.Add(request)
+	for i.requests.Len() > inspectorMaxRequests {
+		i.requests.Remove()
+	}
+
+	path := request.Path
+	stats := i.paths[path]
+	if stats == nil {
+		if len(i.paths) >= inspectorMaxPaths {
+			path = inspectorOtherPaths
+		}
+		stats = i.paths[path]
+		if stats == nil {
+			stats = &pathStats{buckets: make([]int, len(latencyBounds)+1)}
+			i.paths[path] = stats
+		}
+	}
+	stats.count++
+	stats.bytes += request.Bytes
+	bucket := sort.Search(len(latencyBounds), func(index int) bool {
+		return request.Duration < latencyBounds[index]
+	})
+	stats.buckets[bucket]++
+}
+
+// Stats returns the recent requests and the statistics of each path
+func (i *RequestInspector) Stats() frontend.AssetServerStats {
+	result := frontend.AssetServerStats{
+		Requests: []frontend.AssetRequest{},
+		Paths:    []frontend.AssetPathStats{},
+	}
+	if i == nil {
+		return result
+	}
+	i.lock.Lock()
+	defer i.lock.Unlock()
+
+	result.Requests = append(result.Requests, i.requests.Snapshot()...)
+
+	for path, stats := range i.paths {
+		latency := make([]frontend.LatencyBucket, len(stats.buckets))
+		for index, count := range stats.buckets {
+			latency[index].Count = count
+			if index < len(latencyBounds) {
+				latency[index].UpperBound = latencyBounds[index]
+			}
+		}
+		result.Paths = append(result.Paths, frontend.AssetPathStats{
+			Path:    path,
+			Count:   stats.count,
+			Bytes:   stats.bytes,
+			Latency: latency,
+		})
+	}
+	sort.Slice(result.Paths, func(a, b int) bool {
+		return result.Paths[a].Path < result.Paths[b].Path
+	})
+	return result
+}
+
+func (d *AssetServer) serveInspectorStats(rw http.ResponseWriter) {
+	content, err := json.Marshal(requestInspector.Stats())
+	if err != nil {
+		d.serveError(rw, err, "Unable to marshal request statistics")
+		return
+	}
+	rw.Header().Set(HeaderContentType, "application/json")
+	rw.Header().Set(HeaderCacheControl, "no-store")
+	d.writeBlob(rw, inspectorPath, content)
+}
+
+type servedFromKey struct{}
+
+// withServedFrom returns a request that records the source it is served from
+func withServedFrom(req *http.Request) (*http.Request, *string) {
+	servedFrom := new(string)
+	return req.WithContext(context.WithValue(req.Context(), servedFromKey{}, servedFrom)), servedFrom
+}
+
+// setServedFrom records the source the request is served from. The last source set is recorded,
+// as requests may be passed on, EG: from the frontend DevServer to the Handler.
+func setServedFrom(req *http.Request, source string) {
+	if servedFrom, ok := req.Context().Value(servedFromKey{}).(*string); ok {
+		*servedFrom = source
+	}
+}
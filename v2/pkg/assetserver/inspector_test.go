@@ -0,0 +1,73 @@
+package assetserver
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"testing/fstest"
+	"time"
+
+	"github.com/matryer/is"
+	"github.com/wailsapp/wails/v2/internal/frontend"
+	"github.com/wailsapp/wails/v2/pkg/options/assetserver"
+)
+
+func TestRequestInspector(t *testing.T) {
+	is2 := is.New(t)
+
+	inspector := newRequestInspector()
+	for i := 0; i < inspectorMaxRequests+10; i++ {
+		inspector.record(frontend.AssetRequest{Path: fmt.Sprintf("/%d.js", i%3), Bytes: 10, Duration: time.Duration(i%3) * 20 * time.Millisecond})
+	}
+
+	stats := inspector.Stats()
+	is2.Equal(len(stats.Requests), inspectorMaxRequests) // Only the most recent requests are kept
+	is2.Equal(stats.Requests[0].Path, "/1.js")
+	is2.Equal(len(stats.Paths), 3)
+	is2.Equal(stats.Paths[2].Path, "/2.js")
+	is2.Equal(stats.Paths[2].Count, (inspectorMaxRequests+10)/3)
+	is2.Equal(stats.Paths[2].Bytes, int64(stats.Paths[2].Count*10))
+	// 40ms is in the bucket for requests taking between 25ms and 50ms
+	is2.Equal(stats.Paths[2].Latency[4].UpperBound, 50*time.Millisecond)
+	is2.Equal(stats.Paths[2].Latency[4].Count, stats.Paths[2].Count)
+	is2.Equal(stats.Paths[2].Latency[len(latencyBounds)].UpperBound, time.Duration(0))
+
+	// Stats can be read repeatedly
+	is2.Equal(inspector.Stats().Requests, stats.Requests)
+
+	var disabled *RequestInspector
+	disabled.record(frontend.AssetRequest{})
+	is2.Equal(len(disabled.Stats().Requests), 0)
+}
+
+func TestAssetServer_InspectorServedFrom(t *testing.T) {
+	is2 := is.New(t)
+
+	inspector := EnableInspector()
+	is2.Equal(Inspector(), inspector)
+	server, err := NewAssetServer("", assetserver.Options{
+		Assets:  fstest.MapFS{"index.html": {Data: []byte("<html><head></head><body></body></html>")}},
+		Handler: http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) { rw.Write([]byte("handler")) }),
+	}, false, nil, testRuntimeAssets{})
+	is2.NoErr(err)
+
+	for path, servedFrom := range map[string]string{
+		"/":                 frontend.ServedFromFS,
+		"/wails/runtime.js": frontend.ServedFromRuntime,
+		"/api/users":        frontend.ServedFromHandler,
+	} {
+		server.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, path, nil))
+		requests := inspector.Stats().Requests
+		last := requests[len(requests)-1]
+		is2.Equal(last.Path, path)
+		is2.Equal(last.ServedFrom, servedFrom)
+		is2.Equal(last.Status, http.StatusOK)
+		is2.True(last.Bytes > 0)
+	}
+
+	// The statistics are only served by the dev server
+	rw := httptest.NewRecorder()
+	server.ServeHTTP(rw, httptest.NewRequest(http.MethodGet, inspectorPath, nil))
+	is2.Equal(rw.Body.String(), "handler")
+}
@@ -0,0 +1,59 @@
+package assetserver
+
+import (
+	"strconv"
+	"strings"
+)
+
+// precompressedEncodings are the content encodings of precompressed assets, in order of preference.
+// A precompressed asset is stored next to the original asset with the extension appended, EG: `main.js.gz`
+var precompressedEncodings = []struct {
+	encoding  string
+	extension string
+}{
+	{encoding: "br", extension: ".br"},
+	{encoding: "gzip", extension: ".gz"},
+}
+
+// precompressedExtension returns the file extension of assets precompressed with the encoding
+func precompressedExtension(encoding string) string {
+	for _, precompressed := range precompressedEncodings {
+		if precompressed.encoding == encoding {
+			return precompressed.extension
+		}
+	}
+	return ""
+}
+
+// acceptsEncoding returns true if the Accept-Encoding header value allows the given content encoding
+func acceptsEncoding(acceptEncoding string, encoding string) bool {
+	wildcard := false
+	for _, value := range strings.Split(acceptEncoding, ",") {
+		coding, params, _ := strings.Cut(value, ";")
+		coding = strings.ToLower(strings.TrimSpace(coding))
+		accepted := qualityValue(params) > 0
+		switch coding {
+		case encoding:
+			return accepted
+		case "*":
+			wildcard = accepted
+		}
+	}
+	return wildcard
+}
+
+// qualityValue returns the value of the `q` parameter, which defaults to 1
+func qualityValue(params string) float64 {
+	for _, param := range strings.Split(params, ";") {
+		name, value, found := strings.Cut(strings.TrimSpace(param), "=")
+		if !found || !strings.EqualFold(strings.TrimSpace(name), "q") {
+			continue
+		}
+		quality, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+		if err != nil {
+			return 0
+		}
+		return quality
+	}
+	return 1
+}
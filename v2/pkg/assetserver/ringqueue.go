@@ -92,6 +92,15 @@ func (q *ringqueue[T]) Remove() (T, bool) {
 	return i, true
 }
 
+// Snapshot returns a copy of the items in the queue, from the head to the tail
+func (q *ringqueue[T]) Snapshot() []T {
+	result := make([]T, q.cnt)
+	for i := range result {
+		result[i] = q.nodes[(q.head+i)%len(q.nodes)]
+	}
+	return result
+}
+
 func (q *ringqueue[T]) Cap() int {
 	return cap(q.nodes)
 }
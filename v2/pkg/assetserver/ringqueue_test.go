@@ -0,0 +1,30 @@
+package assetserver
+
+import (
+	"testing"
+
+	"github.com/matryer/is"
+)
+
+func TestRingqueueSnapshot(t *testing.T) {
+	is2 := is.New(t)
+
+	queue := newRingqueue[int](4)
+	is2.Equal(queue.Snapshot(), []int{})
+
+	// Wrap around the end of the nodes
+	for i := 1; i <= 6; i++ {
+		queue.Add(i)
+		if queue.Len() > 3 {
+			queue.Remove()
+		}
+	}
+	is2.Equal(queue.Snapshot(), []int{4, 5, 6})
+	is2.Equal(queue.Len(), 3)
+
+	// The snapshot is a copy
+	snapshot := queue.Snapshot()
+	snapshot[0] = 0
+	head, _ := queue.Peek()
+	is2.Equal(head, 4)
+}
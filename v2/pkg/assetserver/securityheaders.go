@@ -0,0 +1,110 @@
+package assetserver
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"net/http"
+	"strings"
+
+	"github.com/wailsapp/wails/v2/pkg/options/assetserver"
+)
+
+// scriptNonce returns a new nonce for the scripts injected into index.html, or an empty
+// string if there is no Content-Security-Policy
+func (d *AssetServer) scriptNonce() (string, error) {
+	if d.securityHeaders == nil || d.securityHeaders.CSP == "" {
+		return "", nil
+	}
+	var data [16]byte
+	if _, err := rand.Read(data[:]); err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(data[:]), nil
+}
+
+// setSecurityHeaders sets the security headers on the response. If a nonce is given, scripts
+// with the nonce are allowed by the Content-Security-Policy.
+func setSecurityHeaders(header http.Header, headers *assetserver.SecurityHeaders, nonce string) {
+	if headers == nil {
+		return
+	}
+	if csp := headers.CSP; csp != "" {
+		if nonce != "" {
+			csp = addScriptNonce(csp, nonce)
+		}
+		header.Set(HeaderContentSecurityPolicy, csp)
+	}
+	if headers.PermissionsPolicy != "" {
+		header.Set(HeaderPermissionsPolicy, headers.PermissionsPolicy)
+	}
+	if headers.ReferrerPolicy != "" {
+		header.Set(HeaderReferrerPolicy, headers.ReferrerPolicy)
+	}
+}
+
+// addScriptNonce adds the nonce to the directives of the Content-Security-Policy that apply to
+// script elements. If there are none, a `script-src` directive is added based on `default-src`.
+// Without either, scripts aren't restricted and the policy is returned unchanged.
+func addScriptNonce(csp string, nonce string) string {
+	source := "'nonce-" + nonce + "'"
+
+	var directives [][]string
+	for _, directive := range strings.Split(csp, ";") {
+		if fields := strings.Fields(directive); len(fields) > 0 {
+			directives = append(directives, fields)
+		}
+	}
+
+	var defaultSources []string
+	hasScriptDirective := false
+	for index, directive := range directives {
+		switch strings.ToLower(directive[0]) {
+		case "script-src", "script-src-elem":
+			directives[index] = addSource(directive, source)
+			hasScriptDirective = true
+		case "default-src":
+			defaultSources = directive[1:]
+		}
+	}
+	if !hasScriptDirective && defaultSources != nil {
+		scriptDirective := append([]string{"script-src"}, defaultSources...)
+		directives = append(directives, addSource(scriptDirective, source))
+	}
+
+	result := make([]string, 0, len(directives))
+	for _, directive := range directives {
+		result = append(result, strings.Join(directive, " "))
+	}
+	return strings.Join(result, "; ")
+}
+
+// addSource adds the nonce source to the directive. 'none' is removed as it can't be combined
+// with other sources. Browsers ignore 'unsafe-inline' once a directive has a nonce, so a
+// directive allowing inline scripts without nonces or hashes gets 'self' instead, which
+// allows the injected scripts without breaking the inline scripts of the page.
+func addSource(directive []string, source string) []string {
+	result := []string{directive[0]}
+	unsafeInline, hasNonceOrHash, hasSelf := false, false, false
+	for _, value := range directive[1:] {
+		lower := strings.ToLower(value)
+		switch {
+		case lower == "'none'":
+			continue
+		case lower == "'unsafe-inline'":
+			unsafeInline = true
+		case lower == "'self'":
+			hasSelf = true
+		case strings.HasPrefix(lower, "'nonce-"), strings.HasPrefix(lower, "'sha"):
+			hasNonceOrHash = true
+		}
+		result = append(result, value)
+	}
+
+	if unsafeInline && !hasNonceOrHash {
+		if !hasSelf {
+			result = append(result, "'self'")
+		}
+		return result
+	}
+	return append(result, source)
+}
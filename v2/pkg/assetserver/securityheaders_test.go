@@ -0,0 +1,75 @@
+package assetserver
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"github.com/matryer/is"
+	"github.com/wailsapp/wails/v2/pkg/options/assetserver"
+)
+
+func TestAddScriptNonce(t *testing.T) {
+	tests := []struct {
+		name string
+		csp  string
+		want string
+	}{
+		{"script-src", "default-src 'self'; script-src 'self'", "default-src 'self'; script-src 'self' 'nonce-abc'"},
+		{"script-src-elem", "script-src 'self'; script-src-elem 'self'", "script-src 'self' 'nonce-abc'; script-src-elem 'self' 'nonce-abc'"},
+		{"default-src only", "default-src 'self' https:; img-src *", "default-src 'self' https:; img-src *; script-src 'self' https: 'nonce-abc'"},
+		{"none", "default-src 'none'", "default-src 'none'; script-src 'nonce-abc'"},
+		{"unsafe-inline", "script-src 'unsafe-inline'", "script-src 'unsafe-inline' 'self'"},
+		{"unsafe-inline with hash", "script-src 'unsafe-inline' 'sha256-xyz'", "script-src 'unsafe-inline' 'sha256-xyz' 'nonce-abc'"},
+		{"no script restriction", "img-src 'self';", "img-src 'self'"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			is2 := is.New(t)
+			is2.Equal(addScriptNonce(tt.csp, "abc"), tt.want)
+		})
+	}
+}
+
+func TestAssetServer_SecurityHeaders(t *testing.T) {
+	is2 := is.New(t)
+
+	assets := fstest.MapFS{
+		"index.html": {Data: []byte("<html><head></head><body></body></html>")},
+		"main.js":    {Data: []byte("console.log('wails')")},
+	}
+	server, err := NewAssetServer("", assetserver.Options{
+		Assets: assets,
+		SecurityHeaders: &assetserver.SecurityHeaders{
+			CSP:               "default-src 'self'",
+			PermissionsPolicy: "camera=()",
+			ReferrerPolicy:    "no-referrer",
+		},
+	}, false, nil, testRuntimeAssets{})
+	is2.NoErr(err)
+
+	rw := httptest.NewRecorder()
+	server.ServeHTTP(rw, httptest.NewRequest(http.MethodGet, "/", nil))
+	is2.Equal(rw.Code, http.StatusOK)
+	csp := rw.Header().Get(HeaderContentSecurityPolicy)
+	is2.True(strings.HasPrefix(csp, "default-src 'self'; script-src 'self' 'nonce-"))
+	nonce := strings.TrimSuffix(strings.TrimPrefix(csp, "default-src 'self'; script-src 'self' 'nonce-"), "'")
+	is2.True(strings.Contains(rw.Body.String(), `<script src="/wails/runtime.js" nonce="`+nonce+`"></script>`))
+	is2.True(strings.Contains(rw.Body.String(), `<script src="/wails/ipc.js" nonce="`+nonce+`"></script>`))
+	is2.Equal(rw.Header().Get(HeaderPermissionsPolicy), "camera=()")
+	is2.Equal(rw.Header().Get(HeaderReferrerPolicy), "no-referrer")
+
+	// Every page load gets a new nonce
+	rw = httptest.NewRecorder()
+	server.ServeHTTP(rw, httptest.NewRequest(http.MethodGet, "/", nil))
+	is2.True(rw.Header().Get(HeaderContentSecurityPolicy) != csp)
+
+	// Other assets get the policy without a nonce
+	rw = httptest.NewRecorder()
+	server.ServeHTTP(rw, httptest.NewRequest(http.MethodGet, "/main.js", nil))
+	is2.Equal(rw.Code, http.StatusOK)
+	is2.Equal(rw.Header().Get(HeaderContentSecurityPolicy), "default-src 'self'")
+	is2.Equal(rw.Header().Get(HeaderReferrerPolicy), "no-referrer")
+}
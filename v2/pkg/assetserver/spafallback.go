@@ -0,0 +1,123 @@
+package assetserver
+
+import (
+	"net/http"
+	"path"
+	"strings"
+
+	"github.com/wailsapp/wails/v2/pkg/options/assetserver"
+)
+
+const defaultSPAFallbackPath = "/index.html"
+
+// UseSPAFallback sets how navigation requests that don't match an asset are handled
+func (d *AssetServer) UseSPAFallback(fallback *assetserver.SPAFallback) {
+	d.spaFallback = fallback
+}
+
+// isSPANavigation returns true if the request is a navigation request that may be served the SPA fallback
+func (d *AssetServer) isSPANavigation(req *http.Request) bool {
+	if d.spaFallback == nil || req.Method != http.MethodGet {
+		return false
+	}
+
+	// Navigations request documents, while paths with a file extension are assets
+	requestPath := req.URL.Path
+	if path.Ext(requestPath) != "" {
+		return false
+	}
+	if !acceptsNavigation(req.Header) {
+		return false
+	}
+
+	for _, pattern := range d.spaFallback.Exclude {
+		if matchPath(pattern, requestPath) {
+			return false
+		}
+	}
+	return true
+}
+
+// acceptsNavigation returns false if the headers show that the request is not a navigation. Webviews that send
+// neither `Sec-Fetch-Mode` nor a specific `Accept` header, like the legacy WebKitGTK which always sends
+// `Accept: */*`, can't tell navigations apart, so their requests are treated as navigations.
+func acceptsNavigation(header http.Header) bool {
+	if mode := header.Get("Sec-Fetch-Mode"); mode != "" {
+		return mode == "navigate"
+	}
+	accept := header.Get("Accept")
+	return accept == "" || strings.Contains(accept, "text/html") || strings.Contains(accept, "*/*")
+}
+
+// serveSPANavigation serves the request from the handler and serves the SPA fallback page instead if no asset was found
+func (d *AssetServer) serveSPANavigation(rw http.ResponseWriter, req *http.Request) {
+	interceptor := &notFoundInterceptor{rw: rw, header: http.Header{}}
+	d.handler.ServeHTTP(interceptor, req)
+	if !interceptor.notFound {
+		return
+	}
+
+	fallbackPath := d.spaFallback.Path
+	if fallbackPath == "" {
+		fallbackPath = defaultSPAFallbackPath
+	}
+	d.logDebug("No asset found for '%s', serving SPA fallback '%s'", req.URL.Path, fallbackPath)
+
+	fallbackReq := req.Clone(req.Context())
+	fallbackReq.URL.Path = fallbackPath
+	fallbackReq.URL.RawPath = ""
+	d.serveIndex(rw, fallbackReq)
+}
+
+// notFoundInterceptor passes the response through to rw, unless the status is 404 Not Found.
+// The response is then discarded so the SPA fallback page can be served instead.
+type notFoundInterceptor struct {
+	rw     http.ResponseWriter
+	header http.Header
+
+	wroteHeader bool
+	notFound    bool
+}
+
+func (rw *notFoundInterceptor) Header() http.Header {
+	if rw.wroteHeader && !rw.notFound {
+		return rw.rw.Header()
+	}
+	// Kept apart until the status is known, so the headers of a 404 don't end up on the fallback page
+	return rw.header
+}
+
+func (rw *notFoundInterceptor) Write(buf []byte) (int, error) {
+	rw.WriteHeader(http.StatusOK)
+	if rw.notFound {
+		return len(buf), nil
+	}
+	return rw.rw.Write(buf)
+}
+
+func (rw *notFoundInterceptor) WriteHeader(code int) {
+	if rw.wroteHeader {
+		return
+	}
+	rw.wroteHeader = true
+	if code == http.StatusNotFound {
+		rw.notFound = true
+		return
+	}
+
+	header := rw.rw.Header()
+	for k, v := range rw.header {
+		header[k] = v
+	}
+	rw.rw.WriteHeader(code)
+}
+
+func (rw *notFoundInterceptor) Flush() {
+	if rw.notFound {
+		return
+	}
+	rw.WriteHeader(http.StatusOK)
+	if flusher, ok := rw.rw.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
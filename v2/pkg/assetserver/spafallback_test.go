@@ -0,0 +1,119 @@
+package assetserver
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"github.com/matryer/is"
+	"github.com/wailsapp/wails/v2/pkg/options/assetserver"
+)
+
+func TestAssetServer_SPAFallback(t *testing.T) {
+	assets := fstest.MapFS{
+		"index.html":       {Data: []byte("<html><head></head><body>index</body></html>")},
+		"app.html":         {Data: []byte("<html><head></head><body>app</body></html>")},
+		"about/index.html": {Data: []byte("<html><head></head><body>about</body></html>")},
+	}
+
+	tests := []struct {
+		name         string
+		options      *assetserver.SPAFallback
+		path         string
+		accept       string
+		wantCode     int
+		wantBody     string
+		wantFallback bool
+	}{
+		{"disabled", nil, "/settings/profile", "text/html", http.StatusNotFound, "", false},
+		{"navigation", &assetserver.SPAFallback{}, "/settings/profile", "text/html,application/xhtml+xml", http.StatusOK, "index", true},
+		{"custom path", &assetserver.SPAFallback{Path: "/app.html"}, "/settings", "text/html", http.StatusOK, "app", true},
+		{"existing page", &assetserver.SPAFallback{}, "/about/", "text/html", http.StatusOK, "about", false},
+		{"file extension", &assetserver.SPAFallback{}, "/missing.js", "text/html", http.StatusNotFound, "", false},
+		{"not a navigation", &assetserver.SPAFallback{}, "/settings", "application/json", http.StatusNotFound, "", false},
+		{"no navigation headers", &assetserver.SPAFallback{}, "/settings/profile", "*/*", http.StatusOK, "index", true},
+		{"no accept header", &assetserver.SPAFallback{}, "/settings/profile", "", http.StatusOK, "index", true},
+		{"excluded without navigation headers", &assetserver.SPAFallback{Exclude: []string{"/api/*"}}, "/api/users/1", "*/*", http.StatusNotFound, "", false},
+		{"excluded", &assetserver.SPAFallback{Exclude: []string{"/api/*"}}, "/api/users/1", "text/html", http.StatusNotFound, "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			is2 := is.New(t)
+
+			options := assetserver.Options{Assets: assets, SPAFallback: tt.options}
+			is2.NoErr(options.Validate())
+			server, err := NewAssetServer("", options, false, nil, testRuntimeAssets{})
+			is2.NoErr(err)
+
+			req := httptest.NewRequest(http.MethodGet, tt.path, nil)
+			if tt.accept != "" {
+				req.Header.Set("Accept", tt.accept)
+			}
+			rw := httptest.NewRecorder()
+			server.ServeHTTP(rw, req)
+
+			is2.Equal(rw.Code, tt.wantCode)
+			is2.True(strings.Contains(rw.Body.String(), tt.wantBody))
+			// The runtime is injected into the fallback page
+			is2.Equal(strings.Contains(rw.Body.String(), `<script src="/wails/runtime.js"></script>`), tt.wantFallback)
+		})
+	}
+}
+
+func TestAcceptsNavigation(t *testing.T) {
+	tests := []struct {
+		name   string
+		header http.Header
+		want   bool
+	}{
+		{"navigate mode", http.Header{"Sec-Fetch-Mode": {"navigate"}, "Accept": {"*/*"}}, true},
+		{"fetch mode", http.Header{"Sec-Fetch-Mode": {"cors"}, "Accept": {"*/*"}}, false},
+		{"html accepted", http.Header{"Accept": {"text/html,application/xhtml+xml"}}, true},
+		{"json accepted", http.Header{"Accept": {"application/json"}}, false},
+		// Legacy WebKitGTK sends this for every request
+		{"anything accepted", http.Header{"Accept": {"*/*"}}, true},
+		{"no headers", http.Header{}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			is2 := is.New(t)
+			is2.Equal(acceptsNavigation(tt.header), tt.want)
+		})
+	}
+}
+
+func TestSPAFallbackValidate(t *testing.T) {
+	is2 := is.New(t)
+
+	options := assetserver.Options{Assets: fstest.MapFS{}, SPAFallback: &assetserver.SPAFallback{Path: "index.html"}}
+	is2.True(options.Validate() != nil) // Path must be absolute
+	options.SPAFallback = &assetserver.SPAFallback{Exclude: []string{"/api/["}}
+	is2.True(options.Validate() != nil) // Invalid pattern
+}
+
+func TestNotFoundInterceptor(t *testing.T) {
+	is2 := is.New(t)
+
+	// A 404 is discarded, including its headers
+	rw := httptest.NewRecorder()
+	interceptor := &notFoundInterceptor{rw: rw, header: http.Header{}}
+	http.NotFound(interceptor, httptest.NewRequest(http.MethodGet, "/missing", nil))
+	is2.True(interceptor.notFound)
+	is2.Equal(rw.Body.Len(), 0)
+	is2.Equal(rw.Header().Get("Content-Type"), "")
+
+	// Other responses are passed through as they are written
+	rw = httptest.NewRecorder()
+	interceptor = &notFoundInterceptor{rw: rw, header: http.Header{}}
+	interceptor.Header().Set("Content-Type", "text/event-stream")
+	_, err := interceptor.Write([]byte("data: 1\n\n"))
+	is2.NoErr(err)
+	interceptor.Flush()
+	is2.True(!interceptor.notFound)
+	is2.True(rw.Flushed)
+	is2.Equal(rw.Code, http.StatusOK)
+	is2.Equal(rw.Header().Get("Content-Type"), "text/event-stream")
+	is2.Equal(rw.Body.String(), "data: 1\n\n")
+}
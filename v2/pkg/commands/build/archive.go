@@ -0,0 +1,125 @@
+package build
+
+import (
+	"archive/zip"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+// archiveApplication writes a zip archive of the compiled application, or of the application
+// bundle on macOS, next to it. It returns the filename of the archive.
+func archiveApplication(options *Options) (string, error) {
+	output := options.CompiledBinary
+	if options.Pack && options.Platform == "darwin" {
+		output = darwinBundleDirectory(options)
+	}
+
+	var modified time.Time
+	if options.Reproducible {
+		epoch, err := sourceDateEpoch(options.ProjectData.Path)
+		if err != nil {
+			return "", err
+		}
+		modified = epoch
+	}
+
+	filename := strings.TrimSuffix(output, filepath.Ext(output)) + ".zip"
+	return filename, writeZip(filename, output, modified)
+}
+
+// archiveEntry is a file or directory added to an archive
+type archiveEntry struct {
+	name string
+	path string
+	info os.FileInfo
+}
+
+// writeZip writes a zip archive of the given file or directory. The entries are sorted by
+// name so that the archive doesn't depend on the order the files are read from disk. If
+// modified is not zero, it is used as the modification time of all entries.
+func writeZip(filename string, root string, modified time.Time) error {
+	var entries []archiveEntry
+	parent := filepath.Dir(root)
+	err := filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		name, err := filepath.Rel(parent, path)
+		if err != nil {
+			return err
+		}
+		name = filepath.ToSlash(name)
+		if info.IsDir() {
+			name += "/"
+		}
+		entries = append(entries, archiveEntry{name: name, path: path, info: info})
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].name < entries[j].name
+	})
+
+	file, err := os.Create(filename)
+	if err != nil {
+		return err
+	}
+	archive := zip.NewWriter(file)
+	for _, entry := range entries {
+		if err := addZipEntry(archive, entry, modified); err != nil {
+			archive.Close()
+			file.Close()
+			return err
+		}
+	}
+	if err := archive.Close(); err != nil {
+		file.Close()
+		return err
+	}
+	return file.Close()
+}
+
+func addZipEntry(archive *zip.Writer, entry archiveEntry, modified time.Time) error {
+	header, err := zip.FileInfoHeader(entry.info)
+	if err != nil {
+		return err
+	}
+	header.Name = entry.name
+	if !modified.IsZero() {
+		header.Modified = modified
+	}
+	if !entry.info.IsDir() {
+		header.Method = zip.Deflate
+	}
+	writer, err := archive.CreateHeader(header)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case entry.info.IsDir():
+		return nil
+	case entry.info.Mode()&os.ModeSymlink != 0:
+		// Symbolic links, such as the ones in macOS frameworks, are stored as their target
+		target, err := os.Readlink(entry.path)
+		if err != nil {
+			return err
+		}
+		_, err = writer.Write([]byte(filepath.ToSlash(target)))
+		return err
+	}
+
+	source, err := os.Open(entry.path)
+	if err != nil {
+		return err
+	}
+	defer source.Close()
+	_, err = io.Copy(writer, source)
+	return err
+}
@@ -0,0 +1,64 @@
+package build
+
+import (
+	"archive/zip"
+	"bytes"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/matryer/is"
+)
+
+func TestWriteZip(t *testing.T) {
+	is2 := is.New(t)
+
+	root := filepath.Join(t.TempDir(), "app.app")
+	// The files are created out of order
+	for _, name := range []string{"Contents/Resources/icon.icns", "Contents/MacOS/app", "Contents/Info.plist"} {
+		filename := filepath.Join(root, filepath.FromSlash(name))
+		is2.NoErr(os.MkdirAll(filepath.Dir(filename), 0755))
+		is2.NoErr(os.WriteFile(filename, []byte(name), 0644))
+	}
+
+	epoch := time.Date(2023, 1, 2, 3, 4, 6, 0, time.UTC)
+	first := filepath.Join(t.TempDir(), "first.zip")
+	is2.NoErr(writeZip(first, root, epoch))
+
+	archive, err := zip.OpenReader(first)
+	is2.NoErr(err)
+	defer archive.Close()
+	var names []string
+	for _, file := range archive.File {
+		names = append(names, file.Name)
+		is2.True(file.Modified.Equal(epoch))
+	}
+	is2.Equal(names, []string{
+		"app.app/",
+		"app.app/Contents/",
+		"app.app/Contents/Info.plist",
+		"app.app/Contents/MacOS/",
+		"app.app/Contents/MacOS/app",
+		"app.app/Contents/Resources/",
+		"app.app/Contents/Resources/icon.icns",
+	})
+	reader, err := archive.File[4].Open()
+	is2.NoErr(err)
+	content, err := io.ReadAll(reader)
+	is2.NoErr(err)
+	is2.Equal(string(content), "Contents/MacOS/app")
+
+	// The archive doesn't depend on the modification times of the files
+	later := time.Now().Add(time.Hour)
+	is2.NoErr(os.Chtimes(filepath.Join(root, "Contents", "Info.plist"), later, later))
+	second := filepath.Join(t.TempDir(), "second.zip")
+	is2.NoErr(writeZip(second, root, epoch))
+
+	firstData, err := os.ReadFile(first)
+	is2.NoErr(err)
+	secondData, err := os.ReadFile(second)
+	is2.NoErr(err)
+	is2.True(bytes.Equal(firstData, secondData))
+}
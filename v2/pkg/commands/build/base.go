@@ -9,6 +9,7 @@ import (
 	"runtime"
 	"strconv"
 	"strings"
+	"sync"
 
 	"github.com/pterm/pterm"
 
@@ -20,9 +21,11 @@ import (
 	"github.com/pkg/errors"
 
 	"github.com/leaanthony/slicer"
+	"github.com/wailsapp/wails/v2/internal/buildinfo"
 	"github.com/wailsapp/wails/v2/internal/fs"
 	"github.com/wailsapp/wails/v2/internal/project"
 	"github.com/wailsapp/wails/v2/internal/shell"
+	"github.com/wailsapp/wails/v2/internal/staticanalysis"
 	"github.com/wailsapp/wails/v2/pkg/clilogger"
 )
 
@@ -30,6 +33,9 @@ const (
 	VERBOSE int = 2
 )
 
+// runtimeWrapperLock guards the generation of the runtime wrapper
+var runtimeWrapperLock sync.Mutex
+
 // BaseBuilder is the common builder struct
 type BaseBuilder struct {
 	filesToDelete slicer.StringSlicer
@@ -160,8 +166,11 @@ func (b *BaseBuilder) OutputFilename(options *Options) string {
 // CompileProject compiles the project
 func (b *BaseBuilder) CompileProject(options *Options) error {
 
-	// Check if the runtime wrapper exists
+	// Check if the runtime wrapper exists. The wrapper is shared by all targets
+	// so only one concurrent build may regenerate it at a time.
+	runtimeWrapperLock.Lock()
 	err := generateRuntimeWrapper(options)
+	runtimeWrapperLock.Unlock()
 	if err != nil {
 		return err
 	}
@@ -169,13 +178,7 @@ func (b *BaseBuilder) CompileProject(options *Options) error {
 	verbose := options.Verbosity == VERBOSE
 	// Run go mod tidy first
 	if !options.SkipModTidy {
-		cmd := exec.Command(options.Compiler, "mod", "tidy")
-		cmd.Stderr = os.Stderr
-		if verbose {
-			println("")
-			cmd.Stdout = os.Stdout
-		}
-		err = cmd.Run()
+		err = runModTidy(options)
 		if err != nil {
 			return err
 		}
@@ -209,10 +212,18 @@ func (b *BaseBuilder) CompileProject(options *Options) error {
 		commands.Add("-a")
 	}
 
-	if options.TrimPath {
+	if options.TrimPath || options.Reproducible {
 		commands.Add("-trimpath")
 	}
 
+	// Reproducible builds record the VCS information of the project, which needs git
+	reproducibleVCS := options.Reproducible && gitCommit(options.ProjectData.Path) != ""
+	if reproducibleVCS {
+		commands.Add("-buildvcs=true")
+	} else if options.Reproducible {
+		pterm.Warning.WithWriter(options.Output).Println("The project is not in a git repository: VCS information will not be embedded.")
+	}
+
 	if options.RaceDetector {
 		commands.Add("-race")
 	}
@@ -245,6 +256,15 @@ func (b *BaseBuilder) CompileProject(options *Options) error {
 
 	tags.Deduplicate()
 
+	info, err := options.buildInfo(tags.AsSlice())
+	if err != nil {
+		return err
+	}
+	encodedInfo, err := buildinfo.Encode(info)
+	if err != nil {
+		return err
+	}
+
 	// Add the output type build tag
 	commands.Add("-tags")
 	commands.Add(tags.Join(","))
@@ -262,6 +282,12 @@ func (b *BaseBuilder) CompileProject(options *Options) error {
 		}
 	}
 
+	if options.Reproducible {
+		ldflags.Add("-buildid=")
+	}
+
+	ldflags.Add("-X " + buildinfo.Variable + "=" + encodedInfo)
+
 	ldflags.Deduplicate()
 
 	if ldflags.Length() > 0 {
@@ -288,10 +314,10 @@ func (b *BaseBuilder) CompileProject(options *Options) error {
 
 	// Build the application
 	cmd := exec.Command(compiler, commands.AsSlice()...)
-	cmd.Stderr = os.Stderr
+	cmd.Stderr = options.commandOutput(os.Stderr)
 	if verbose {
-		pterm.Info.Println("Build command:", compiler, commandPrettifier(commands.AsSlice()))
-		cmd.Stdout = os.Stdout
+		pterm.Info.WithWriter(options.Output).Println("Build command:", compiler, commandPrettifier(commands.AsSlice()))
+		cmd.Stdout = options.commandOutput(os.Stdout)
 	}
 	// Set the directory
 	cmd.Dir = b.projectData.Path
@@ -306,6 +332,14 @@ func (b *BaseBuilder) CompileProject(options *Options) error {
 
 	cmd.Env = os.Environ() // inherit env
 
+	if options.Reproducible {
+		epoch, err := sourceDateEpoch(options.ProjectData.Path)
+		if err != nil {
+			return err
+		}
+		cmd.Env = shell.SetEnv(cmd.Env, "SOURCE_DATE_EPOCH", strconv.FormatInt(epoch.Unix(), 10))
+	}
+
 	if options.Platform != "windows" {
 		// Use shell.UpsertEnv so we don't overwrite user's CGO_CFLAGS
 		cmd.Env = shell.UpsertEnv(cmd.Env, "CGO_CFLAGS", func(v string) string {
@@ -366,12 +400,12 @@ func (b *BaseBuilder) CompileProject(options *Options) error {
 	})
 
 	if verbose {
-		printBulletPoint("Environment:", strings.Join(cmd.Env, " "))
+		fprintBulletPoint(options.Output, "Environment:", strings.Join(cmd.Env, " "))
 	}
 
 	// Run command
 	err = cmd.Run()
-	cmd.Stderr = os.Stderr
+	cmd.Stderr = options.commandOutput(os.Stderr)
 
 	// Format error if we have one
 	if err != nil {
@@ -380,7 +414,7 @@ func (b *BaseBuilder) CompileProject(options *Options) error {
 			stdErr := string(output)
 			if strings.Contains(err.Error(), "ld: framework not found UniformTypeIdentifiers") ||
 				strings.Contains(stdErr, "ld: framework not found UniformTypeIdentifiers") {
-				pterm.Warning.Println(`
+				pterm.Warning.WithWriter(options.Output).Println(`
 NOTE: It would appear that you do not have the latest Xcode cli tools installed.
 Please reinstall by doing the following:
   1. Remove the current installation located at "xcode-select -p", EG: sudo rm -rf /Library/Developer/CommandLineTools
@@ -394,11 +428,11 @@ Please reinstall by doing the following:
 		return nil
 	}
 
-	printBulletPoint("Compressing application: ")
+	fprintBulletPoint(options.Output, "Compressing application: ")
 
 	// Do we have upx installed?
 	if !shell.CommandExists("upx") {
-		pterm.Warning.Println("Warning: Cannot compress binary: upx not found")
+		pterm.Warning.WithWriter(options.Output).Println("Warning: Cannot compress binary: upx not found")
 		return nil
 	}
 
@@ -410,21 +444,58 @@ Please reinstall by doing the following:
 	}
 
 	if verbose {
-		pterm.Info.Println("upx", strings.Join(args, " "))
+		pterm.Info.WithWriter(options.Output).Println("upx", strings.Join(args, " "))
 	}
 
 	output, err := exec.Command("upx", args...).Output()
 	if err != nil {
 		return errors.Wrap(err, "Error during compression:")
 	}
-	pterm.Println("Done.")
+	pterm.Fprintln(options.Output, "Done.")
 	if verbose {
-		pterm.Info.Println(string(output))
+		pterm.Info.WithWriter(options.Output).Println(string(output))
 	}
 
 	return nil
 }
 
+// runModTidy runs `go mod tidy` unless the Go sources have not changed since it last ran
+func runModTidy(options *Options) error {
+	if modTidyUpToDate(options) {
+		return nil
+	}
+	cmd := exec.Command(options.Compiler, "mod", "tidy")
+	cmd.Stderr = os.Stderr
+	if options.Verbosity == VERBOSE {
+		println("")
+		cmd.Stdout = os.Stdout
+	}
+	if err := cmd.Run(); err != nil {
+		return err
+	}
+	return storeModTidyKey(options)
+}
+
+func modTidyKey(options *Options) (string, error) {
+	return goSourcesKey(options.ProjectData, map[string]string{"compiler": options.Compiler})
+}
+
+func modTidyUpToDate(options *Options) bool {
+	key, err := modTidyKey(options)
+	if err != nil {
+		return false
+	}
+	return newBuildCache(options).upToDate(cacheStepModTidy, key)
+}
+
+func storeModTidyKey(options *Options) error {
+	key, err := modTidyKey(options)
+	if err != nil {
+		return err
+	}
+	return newBuildCache(options).store(cacheStepModTidy, key)
+}
+
 func generateRuntimeWrapper(options *Options) error {
 
 	if options.WailsJSDir == "" {
@@ -461,38 +532,15 @@ func (b *BaseBuilder) NpmInstallUsingCommand(sourceDir string, installCommand st
 		return nil
 	}
 
-	install := false
-
-	// Get the MD5 sum of package.json
-	packageJSONMD5 := fs.MustMD5File(packageJSON)
-
-	// Check whether we need to npm install
-	packageChecksumFile := filepath.Join(sourceDir, "package.json.md5")
-	if fs.FileExists(packageChecksumFile) {
-		// Compare checksums
-		storedChecksum := fs.MustLoadString(packageChecksumFile)
-		if storedChecksum != packageJSONMD5 {
-			fs.MustWriteString(packageChecksumFile, packageJSONMD5)
-			install = true
-		}
-	} else {
-		install = true
-		fs.MustWriteString(packageChecksumFile, packageJSONMD5)
+	cache := newBuildCache(b.options)
+	installKey, err := frontendInstallKey(sourceDir, installCommand)
+	if err != nil {
+		return err
 	}
 
-	// Install if node_modules doesn't exist
+	// Install if the dependencies have changed or node_modules doesn't exist
 	nodeModulesDir := filepath.Join(sourceDir, "node_modules")
-	if !fs.DirExists(nodeModulesDir) {
-		install = true
-	}
-
-	// check if forced install
-	if b.options.ForceBuild {
-		install = true
-	}
-
-	// Shortcut installation
-	if install == false {
+	if fs.DirExists(nodeModulesDir) && cache.upToDate(cacheStepFrontendInstall, installKey) {
 		if verbose {
 			pterm.Println("Skipping npm install")
 		}
@@ -510,8 +558,17 @@ func (b *BaseBuilder) NpmInstallUsingCommand(sourceDir string, installCommand st
 			pterm.Printf("    %s\n", l)
 		}
 	}
+	if err != nil {
+		cache.invalidate(cacheStepFrontendInstall)
+		return err
+	}
 
-	return err
+	// The install may have updated the lock file, so the key is computed again
+	installKey, err = frontendInstallKey(sourceDir, installCommand)
+	if err != nil {
+		return err
+	}
+	return cache.store(cacheStepFrontendInstall, installKey)
 }
 
 // NpmRun executes the npm target in the provided directory
@@ -592,6 +649,24 @@ func (b *BaseBuilder) BuildFrontend(outputLogger *clilogger.CLILogger) error {
 	}
 
 	printBulletPoint("Compiling frontend: ")
+
+	cache := newBuildCache(b.options)
+	outputDirs, err := b.frontendOutputDirs(frontendDir)
+	if err != nil {
+		return err
+	}
+	buildKey := ""
+	if outputDirs != nil {
+		buildKey, err = frontendBuildKey(frontendDir, installCommand+"\x00"+buildCommand, outputDirs)
+		if err != nil {
+			return err
+		}
+		if cache.upToDate(cacheStepFrontendBuild, buildKey) {
+			pterm.Println("Up to date.")
+			return nil
+		}
+	}
+
 	cmd := strings.Split(buildCommand, " ")
 	if verbose {
 		pterm.Println("")
@@ -607,9 +682,53 @@ func (b *BaseBuilder) BuildFrontend(outputLogger *clilogger.CLILogger) error {
 		}
 	}
 	if err != nil {
+		cache.invalidate(cacheStepFrontendBuild)
 		return err
 	}
 
+	// Some frontend tooling writes files next to the sources, so the key is computed again
+	if outputDirs != nil {
+		buildKey, err = frontendBuildKey(frontendDir, installCommand+"\x00"+buildCommand, outputDirs)
+		if err != nil {
+			return err
+		}
+		if err := cache.store(cacheStepFrontendBuild, buildKey); err != nil {
+			return err
+		}
+	}
+
 	pterm.Println("Done.")
 	return nil
 }
+
+// frontendOutputDirs returns the embedded directories that live inside the frontend directory.
+// nil is returned if the frontend build can't be cached, either because the output has not been
+// built yet or because the output can't be separated from the frontend sources.
+func (b *BaseBuilder) frontendOutputDirs(frontendDir string) ([]string, error) {
+	embedDetails, err := staticanalysis.GetEmbedDetails(b.projectData.Path)
+	if err != nil {
+		return nil, err
+	}
+	result := []string{}
+	for _, embedDetail := range embedDetails {
+		outputDir := embedDetail.GetFullPath()
+		relativePath, err := filepath.Rel(frontendDir, outputDir)
+		if err != nil {
+			continue
+		}
+		if relativePath == "." || (strings.HasPrefix(relativePath, "..") && strings.HasPrefix(frontendDir, outputDir+string(filepath.Separator))) {
+			// The frontend sources are embedded
+			return nil, nil
+		}
+		if strings.HasPrefix(relativePath, "..") {
+			continue
+		}
+		entries, err := os.ReadDir(outputDir)
+		if err != nil || len(entries) == 0 || (len(entries) == 1 && entries[0].Name() == "gitkeep") {
+			// Nothing has been built yet
+			return nil, nil
+		}
+		result = append(result, outputDir)
+	}
+	return result, nil
+}
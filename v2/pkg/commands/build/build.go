@@ -2,12 +2,14 @@ package build
 
 import (
 	"fmt"
+	"io"
 	"os"
+	"os/exec"
 	"path/filepath"
 	"runtime"
 	"strings"
+	"time"
 
-	"github.com/google/shlex"
 	"github.com/pterm/pterm"
 	"github.com/samber/lo"
 
@@ -34,6 +36,18 @@ const (
 	Debug
 )
 
+func (m Mode) String() string {
+	switch m {
+	case Dev:
+		return "dev"
+	case Production:
+		return "production"
+	case Debug:
+		return "debug"
+	}
+	return ""
+}
+
 // Options contains all the build options as well as the project data
 type Options struct {
 	LDFlags           string               // Optional flags to pass to linker
@@ -69,25 +83,73 @@ type Options struct {
 	Obfuscated        bool                 // Indicates that bound methods should be obfuscated
 	GarbleArgs        string               // The arguments for Garble
 	SkipBindings      bool                 // Skip binding generation
+	Jobs              int                  // Number of targets to compile concurrently
+	FailFast          bool                 // Stop building remaining targets after the first failure
+	NoCache           bool                 // Ignore the build cache and run every build step
+	WailsVersion      string               // The version of Wails used for the build
+	HookTimeout       time.Duration        // Maximum time a build hook may run. Overrides the project's buildHookTimeout
+	PreBuildHooks     map[string]Hook      // Go hooks run before the build, keyed like the project's preBuildHooks
+	PostBuildHooks    map[string]Hook      // Go hooks run after the build, keyed like the project's postBuildHooks
+	Reproducible      bool                 // Produce byte-for-byte reproducible output
+	SBOM              bool                 // Generate a bill of materials and license notice for the application
+	SBOMFormat        string               // The bill of materials format: cyclonedx or spdx. Overrides the project's sbom format
+	Precompress       bool                 // Write gzip and brotli compressed siblings of the embedded assets
+	Archive           bool                 // Create a zip archive of the built application
+	Output            io.Writer            // Where the output of the build is written. Defaults to the terminal
 }
 
 // Build the project!
 func Build(options *Options) (string, error) {
 
-	// Extract logger
-	outputLogger := options.Logger
-
-	// Get working directory
-	cwd, err := os.Getwd()
+	builder, err := newBuilder(options)
 	if err != nil {
 		return "", err
 	}
 
+	// Set up our clean up method
+	defer builder.CleanUp()
+
+	hookEvent := HookEvent{
+		Platform: options.Platform,
+		Arch:     options.Arch,
+	}
+
+	hookEvent.Stage = PreBuild
+	if err := runSingleBuildHooks(options, hookEvent); err != nil {
+		return "", err
+	}
+
+	if err := buildSharedAssets(builder, options); err != nil {
+		return "", err
+	}
+
+	compileBinary := ""
+	if !options.IgnoreApplication {
+		compileBinary, err = execBuildApplication(builder, options)
+		if err != nil {
+			return "", err
+		}
+
+		hookEvent.Stage = PostBuild
+		hookEvent.Bin = compileBinary
+		if err := runSingleBuildHooks(options, hookEvent); err != nil {
+			return "", err
+		}
+
+	}
+	return compileBinary, nil
+}
+
+// newBuilder sets up the project related options and creates the builder for the requested output type
+func newBuilder(options *Options) (Builder, error) {
+
 	// wails js dir
 	options.WailsJSDir = options.ProjectData.GetWailsJSDir()
 
 	// Set build directory
-	options.BinDirectory = filepath.Join(options.ProjectData.GetBuildDir(), "bin")
+	if options.BinDirectory == "" {
+		options.BinDirectory = filepath.Join(options.ProjectData.GetBuildDir(), "bin")
+	}
 
 	// Save the project type
 	options.ProjectData.OutputType = options.OutputType
@@ -101,61 +163,60 @@ func Build(options *Options) (string, error) {
 	case "dev":
 		builder = newDesktopBuilder(options)
 	default:
-		return "", fmt.Errorf("cannot build assets for output type %s", options.ProjectData.OutputType)
+		return nil, fmt.Errorf("cannot build assets for output type %s", options.ProjectData.OutputType)
 	}
 
-	// Set up our clean up method
-	defer builder.CleanUp()
-
 	// Initialise Builder
 	builder.SetProjectData(options.ProjectData)
 
-	hookArgs := map[string]string{
-		"${platform}": options.Platform + "/" + options.Arch,
-	}
+	return builder, nil
+}
 
-	for _, hook := range []string{options.Platform + "/" + options.Arch, options.Platform + "/*", "*/*"} {
-		if err := execPreBuildHook(outputLogger, options, hook, hookArgs); err != nil {
-			return "", err
-		}
+// buildSharedAssets runs the steps that are independent of the target platform:
+// creating the embed directories, generating the bindings and building the frontend
+func buildSharedAssets(builder Builder, options *Options) error {
+
+	// Get working directory
+	cwd, err := os.Getwd()
+	if err != nil {
+		return err
 	}
 
 	// Create embed directories if they don't exist
 	if err := CreateEmbedDirectories(cwd, options); err != nil {
-		return "", err
+		return err
 	}
 
 	// Generate bindings
 	if !options.SkipBindings {
 		err = GenerateBindings(options)
 		if err != nil {
-			return "", err
+			return err
 		}
 	}
 
 	if !options.IgnoreFrontend {
-		err = builder.BuildFrontend(outputLogger)
+		err = builder.BuildFrontend(options.Logger)
 		if err != nil {
-			return "", err
+			return err
 		}
 	}
 
-	compileBinary := ""
-	if !options.IgnoreApplication {
-		compileBinary, err = execBuildApplication(builder, options)
+	// Assets are served from disk in dev mode, where compressed siblings would become stale
+	if options.Mode != Dev && (options.Precompress || options.ProjectData.Precompress) {
+		brotli, err := exec.LookPath("brotli")
 		if err != nil {
-			return "", err
+			pterm.Warning.Println("brotli not found: only gzip compressed assets will be written")
 		}
-
-		hookArgs["${bin}"] = compileBinary
-		for _, hook := range []string{options.Platform + "/" + options.Arch, options.Platform + "/*", "*/*"} {
-			if err := execPostBuildHook(outputLogger, options, hook, hookArgs); err != nil {
-				return "", err
-			}
+		printBulletPoint("Precompressing assets: ")
+		count, err := precompressAssets(options, brotli)
+		if err != nil {
+			return err
 		}
-
+		pterm.Printf("Done. (%d assets)\n", count)
 	}
-	return compileBinary, nil
+
+	return nil
 }
 
 func CreateEmbedDirectories(cwd string, buildOptions *Options) error {
@@ -200,6 +261,11 @@ func fatal(message string) {
 }
 
 func printBulletPoint(text string, args ...any) {
+	fprintBulletPoint(nil, text, args...)
+}
+
+// fprintBulletPoint writes a bullet point to the writer, or to the default output if it is nil
+func fprintBulletPoint(writer io.Writer, text string, args ...any) {
 	item := pterm.BulletListItem{
 		Level: 2,
 		Text:  text,
@@ -209,7 +275,16 @@ func printBulletPoint(text string, args ...any) {
 		fatal(err.Error())
 	}
 	t = strings.Trim(t, "\n\r")
-	pterm.Printf(t, args...)
+	pterm.Fprint(writer, pterm.Sprintf(t, args...))
+}
+
+// commandOutput returns the writer for the output of the commands run by the build: the
+// Output of the options if it is set, otherwise the given terminal stream
+func (o *Options) commandOutput(terminal *os.File) io.Writer {
+	if o.Output != nil {
+		return o.Output
+	}
+	return terminal
 }
 
 func GenerateBindings(buildOptions *Options) error {
@@ -222,14 +297,33 @@ func GenerateBindings(buildOptions *Options) error {
 		printBulletPoint("Generating bindings: ")
 	}
 
+	cache := newBuildCache(buildOptions)
+	bindingsSettings := map[string]string{
+		"tags":     strings.Join(buildOptions.UserTags, ","),
+		"tsprefix": buildOptions.ProjectData.Bindings.TsGeneration.Prefix,
+		"tssuffix": buildOptions.ProjectData.Bindings.TsGeneration.Suffix,
+	}
+	bindingsKey, err := goSourcesKey(buildOptions.ProjectData, bindingsSettings)
+	if err != nil {
+		return err
+	}
+	bindingsDir := filepath.Join(buildOptions.ProjectData.GetWailsJSDir(), "wailsjs", "go")
+	if fs.DirExists(bindingsDir) && cache.upToDate(cacheStepBindings, bindingsKey) {
+		pterm.Println("Up to date.")
+		return nil
+	}
+
+	goModTidy := !buildOptions.SkipModTidy && !modTidyUpToDate(buildOptions)
+
 	// Generate Bindings
 	output, err := bindings.GenerateBindings(bindings.Options{
 		Tags:      buildOptions.UserTags,
-		GoModTidy: !buildOptions.SkipModTidy,
+		GoModTidy: goModTidy,
 		TsPrefix:  buildOptions.ProjectData.Bindings.TsGeneration.Prefix,
 		TsSuffix:  buildOptions.ProjectData.Bindings.TsGeneration.Suffix,
 	})
 	if err != nil {
+		cache.invalidate(cacheStepBindings)
 		return err
 	}
 
@@ -237,33 +331,47 @@ func GenerateBindings(buildOptions *Options) error {
 		pterm.Info.Println(output)
 	}
 
+	// go mod tidy may have changed go.mod and go.sum, so the keys are computed after generation
+	if goModTidy {
+		if err := storeModTidyKey(buildOptions); err != nil {
+			return err
+		}
+	}
+	bindingsKey, err = goSourcesKey(buildOptions.ProjectData, bindingsSettings)
+	if err != nil {
+		return err
+	}
+	if err := cache.store(cacheStepBindings, bindingsKey); err != nil {
+		return err
+	}
+
 	pterm.Println("Done.")
 
 	return nil
 }
 
-func execBuildApplication(builder Builder, options *Options) (string, error) {
+func execBuildApplication(builder Builder, options *Options) (_ string, err error) {
 	// If we are building for windows, we will need to generate the asset bundle before
 	// compilation. This will be a .syso file in the project root
 	if options.Pack && options.Platform == "windows" {
 		printBulletPoint("Generating application assets: ")
-		err := packageApplicationForWindows(options)
+		err = packageApplicationForWindows(options)
 		if err != nil {
 			return "", err
 		}
 		pterm.Println("Done.")
 
-		// When we finish, we will want to remove the syso file
+		// When we finish, we will want to remove the syso file. This may run concurrently with
+		// the builds of other targets, so report a failure as the result of this target.
 		defer func() {
-			err := os.Remove(filepath.Join(options.ProjectData.Path, options.ProjectData.Name+"-res.syso"))
-			if err != nil {
-				fatal(err.Error())
+			if removeErr := os.Remove(windowsResourceFile(options)); removeErr != nil && err == nil {
+				err = removeErr
 			}
 		}()
 	}
 
 	// Compile the application
-	printBulletPoint("Compiling application: ")
+	fprintBulletPoint(options.Output, "Compiling application: ")
 
 	if options.Platform == "darwin" && options.Arch == "universal" {
 		outputFile := builder.OutputFilename(options)
@@ -275,7 +383,7 @@ func execBuildApplication(builder Builder, options *Options) (string, error) {
 		options.OutputFile = amd64Filename
 		options.CleanBinDirectory = false
 		if options.Verbosity == VERBOSE {
-			pterm.Println("Building AMD64 Target: " + filepath.Join(options.BinDirectory, options.OutputFile))
+			pterm.Fprintln(options.Output, "Building AMD64 Target: "+filepath.Join(options.BinDirectory, options.OutputFile))
 		}
 		err := builder.CompileProject(options)
 		if err != nil {
@@ -286,7 +394,7 @@ func execBuildApplication(builder Builder, options *Options) (string, error) {
 		options.OutputFile = arm64Filename
 		options.CleanBinDirectory = false
 		if options.Verbosity == VERBOSE {
-			pterm.Println("Building ARM64 Target: " + filepath.Join(options.BinDirectory, options.OutputFile))
+			pterm.Fprintln(options.Output, "Building ARM64 Target: "+filepath.Join(options.BinDirectory, options.OutputFile))
 		}
 		err = builder.CompileProject(options)
 
@@ -295,7 +403,7 @@ func execBuildApplication(builder Builder, options *Options) (string, error) {
 		}
 		// Run lipo
 		if options.Verbosity == VERBOSE {
-			pterm.Println(fmt.Sprintf("Running lipo: lipo -create -output %s %s %s", outputFile, amd64Filename, arm64Filename))
+			pterm.Fprintln(options.Output, fmt.Sprintf("Running lipo: lipo -create -output %s %s %s", outputFile, amd64Filename, arm64Filename))
 		}
 		_, stderr, err := shell.RunCommand(options.BinDirectory, "lipo", "-create", "-output", outputFile, amd64Filename, arm64Filename)
 		if err != nil {
@@ -319,19 +427,46 @@ func execBuildApplication(builder Builder, options *Options) (string, error) {
 		}
 	}
 
-	pterm.Println("Done.")
+	pterm.Fprintln(options.Output, "Done.")
 
 	// Do we need to pack the app for non-windows?
 	if options.Pack && options.Platform != "windows" {
 
-		printBulletPoint("Packaging application: ")
+		fprintBulletPoint(options.Output, "Packaging application: ")
 
 		// TODO: Allow cross platform build
 		err := packageProject(options, runtime.GOOS)
 		if err != nil {
 			return "", err
 		}
-		pterm.Println("Done.")
+		pterm.Fprintln(options.Output, "Done.")
+	}
+
+	if options.SBOM {
+		fprintBulletPoint(options.Output, "Generating SBOM: ")
+		err := generateSBOM(options)
+		if err != nil {
+			return "", err
+		}
+		pterm.Fprintln(options.Output, "Done.")
+	}
+
+	if options.Reproducible {
+		output := options.CompiledBinary
+		if options.Pack && options.Platform == "darwin" {
+			output = darwinBundleDirectory(options)
+		}
+		if err := normaliseTimestamps(options, output); err != nil {
+			return "", err
+		}
+	}
+
+	if options.Archive {
+		fprintBulletPoint(options.Output, "Archiving application: ")
+		if _, err := archiveApplication(options); err != nil {
+			return "", err
+		}
+		pterm.Fprintln(options.Output, "Done.")
 	}
 
 	if options.Platform == "windows" {
@@ -340,90 +475,17 @@ func execBuildApplication(builder Builder, options *Options) (string, error) {
 		tags := options.UserTags
 		if lo.Contains(tags, nativeWebView2Loader) {
 			message := "You are using the legacy native WebView2Loader. This loader will be deprecated in the near future. Please report any bugs related to the new loader: https://github.com/wailsapp/wails/issues/2004"
-			pterm.Warning.Println(message)
+			pterm.Warning.WithWriter(options.Output).Println(message)
 		} else {
 			tags = append(tags, nativeWebView2Loader)
 			message := fmt.Sprintf("Wails is now using the new Go WebView2Loader. If you encounter any issues with it, please report them to https://github.com/wailsapp/wails/issues/2004. You could also use the old legacy loader with `-tags %s`, but keep in mind this will be deprecated in the near future.", strings.Join(tags, ","))
-			pterm.Info.Println(message)
+			pterm.Info.WithWriter(options.Output).Println(message)
 		}
 	}
 
 	if options.Platform == "darwin" && (options.Mode == Debug || options.Devtools) {
-		pterm.Warning.Println("This darwin build contains the use of private APIs. This will not pass Apple's AppStore approval process. Please use it only as a test build for testing and debug purposes.")
+		pterm.Warning.WithWriter(options.Output).Println("This darwin build contains the use of private APIs. This will not pass Apple's AppStore approval process. Please use it only as a test build for testing and debug purposes.")
 	}
 
 	return options.CompiledBinary, nil
 }
-
-func execPreBuildHook(outputLogger *clilogger.CLILogger, options *Options, hookIdentifier string, argReplacements map[string]string) error {
-	preBuildHook := options.ProjectData.PreBuildHooks[hookIdentifier]
-	if preBuildHook == "" {
-		return nil
-	}
-
-	return executeBuildHook(outputLogger, options, hookIdentifier, argReplacements, preBuildHook, "pre")
-}
-
-func execPostBuildHook(outputLogger *clilogger.CLILogger, options *Options, hookIdentifier string, argReplacements map[string]string) error {
-	postBuildHook := options.ProjectData.PostBuildHooks[hookIdentifier]
-	if postBuildHook == "" {
-		return nil
-	}
-
-	return executeBuildHook(outputLogger, options, hookIdentifier, argReplacements, postBuildHook, "post")
-
-}
-
-func executeBuildHook(_ *clilogger.CLILogger, options *Options, hookIdentifier string, argReplacements map[string]string, buildHook string, hookName string) error {
-	if !options.ProjectData.RunNonNativeBuildHooks {
-		if hookIdentifier == "" {
-			// That's the global hook
-		} else {
-			platformOfHook := strings.Split(hookIdentifier, "/")[0]
-			if platformOfHook == "*" {
-				// That's OK, we don't have a specific platform of the hook
-			} else if platformOfHook == runtime.GOOS {
-				// The hook is for host platform
-			} else {
-				// Skip a hook which is not native
-				printBulletPoint(fmt.Sprintf("Non native build hook '%s': Skipping.", hookIdentifier))
-				return nil
-			}
-		}
-	}
-
-	printBulletPoint("Executing %s build hook '%s': ", hookName, hookIdentifier)
-	args, err := shlex.Split(buildHook)
-	if err != nil {
-		return fmt.Errorf("could not parse %s build hook command: %w", hookName, err)
-	}
-	for i, arg := range args {
-		newArg := argReplacements[arg]
-		if newArg == "" {
-			continue
-		}
-		args[i] = newArg
-	}
-
-	if options.Verbosity == VERBOSE {
-		pterm.Info.Println(strings.Join(args, " "))
-
-	}
-
-	if !fs.DirExists(options.BinDirectory) {
-		if err := fs.MkDirs(options.BinDirectory); err != nil {
-			return fmt.Errorf("could not create target directory: %s", err.Error())
-		}
-	}
-
-	stdout, stderr, err := shell.RunCommand(options.BinDirectory, args[0], args[1:]...)
-	if options.Verbosity == VERBOSE {
-		pterm.Info.Println(stdout)
-	}
-	if err != nil {
-		return fmt.Errorf("%s - %s", err.Error(), stderr)
-	}
-	pterm.Println("Done.")
-
-	return nil
-}
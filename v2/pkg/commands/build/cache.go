@@ -0,0 +1,261 @@
+package build
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"io"
+	iofs "io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/samber/lo"
+	"github.com/wailsapp/wails/v2/internal/fs"
+	"github.com/wailsapp/wails/v2/internal/project"
+	"github.com/wailsapp/wails/v2/internal/shell"
+)
+
+const cacheDirName = ".wailscache"
+
+// Names of the build steps that are cached
+const (
+	cacheStepFrontendInstall = "frontend-install"
+	cacheStepFrontendBuild   = "frontend-build"
+	cacheStepBindings        = "bindings"
+	cacheStepModTidy         = "gomodtidy"
+)
+
+// frontendLockFiles are the package manager lock files that affect the frontend install
+var frontendLockFiles = []string{"package-lock.json", "yarn.lock", "pnpm-lock.yaml", "bun.lockb"}
+
+// CacheDir returns the directory used to store the build cache for the given project
+func CacheDir(projectData *project.Project) string {
+	return filepath.Join(projectData.GetBuildDir(), cacheDirName)
+}
+
+// CleanCache removes the build cache of the given project
+func CleanCache(projectData *project.Project) error {
+	return os.RemoveAll(CacheDir(projectData))
+}
+
+// buildCache stores a content hash of the inputs of each build step, so that
+// steps whose inputs have not changed since their last successful run can be skipped
+type buildCache struct {
+	dir     string
+	enabled bool
+}
+
+func newBuildCache(options *Options) *buildCache {
+	if options.ProjectData == nil {
+		return &buildCache{}
+	}
+	return &buildCache{
+		dir:     CacheDir(options.ProjectData),
+		enabled: !options.NoCache && !options.ForceBuild,
+	}
+}
+
+// upToDate returns true if the given key matches the key stored for the step
+func (c *buildCache) upToDate(step string, key string) bool {
+	if !c.enabled || key == "" {
+		return false
+	}
+	stored, err := os.ReadFile(c.filename(step))
+	if err != nil {
+		return false
+	}
+	return string(stored) == key
+}
+
+// store saves the key for the given step
+func (c *buildCache) store(step string, key string) error {
+	if c.dir == "" || key == "" {
+		return nil
+	}
+	if !fs.DirExists(c.dir) {
+		if err := fs.MkDirs(c.dir, 0755); err != nil {
+			return err
+		}
+	}
+	return os.WriteFile(c.filename(step), []byte(key), 0644)
+}
+
+// invalidate removes the stored key for the given step
+func (c *buildCache) invalidate(step string) {
+	if c.dir == "" {
+		return
+	}
+	_ = os.Remove(c.filename(step))
+}
+
+func (c *buildCache) filename(step string) string {
+	return filepath.Join(c.dir, step+".key")
+}
+
+// cacheKey builds a content hash from strings, files and directory trees
+type cacheKey struct {
+	hash hash.Hash
+}
+
+func newCacheKey() *cacheKey {
+	return &cacheKey{hash: sha256.New()}
+}
+
+func (k *cacheKey) addString(name string, value string) {
+	_, _ = io.WriteString(k.hash, name+"\x00"+value+"\x00")
+}
+
+// addFile adds the name and contents of the file to the key. Missing files are recorded as missing.
+func (k *cacheKey) addFile(name string, filename string) error {
+	file, err := os.Open(filename)
+	if os.IsNotExist(err) {
+		k.addString(name, "<missing>")
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	k.addString("file", name)
+	_, err = io.Copy(k.hash, file)
+	return err
+}
+
+// addDir adds all files below root for which include returns true. Directories for
+// which skipDir returns true are not walked.
+func (k *cacheKey) addDir(root string, skipDir func(path string, name string) bool, include func(path string) bool) error {
+	return filepath.WalkDir(root, func(path string, d iofs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			if path != root && skipDir(path, d.Name()) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !d.Type().IsRegular() || !include(path) {
+			return nil
+		}
+		relativePath, err := filepath.Rel(root, path)
+		if err != nil {
+			return err
+		}
+		return k.addFile(filepath.ToSlash(relativePath), path)
+	})
+}
+
+func (k *cacheKey) String() string {
+	return hex.EncodeToString(k.hash.Sum(nil))
+}
+
+// frontendInstallKey returns the cache key for installing the frontend dependencies
+func frontendInstallKey(sourceDir string, installCommand string) (string, error) {
+	key := newCacheKey()
+	key.addString("command", installCommand)
+	for _, filename := range append([]string{"package.json"}, frontendLockFiles...) {
+		if err := key.addFile(filename, filepath.Join(sourceDir, filename)); err != nil {
+			return "", err
+		}
+	}
+	return key.String(), nil
+}
+
+// frontendBuildKey returns the cache key for building the frontend. The output
+// directories, node_modules and hidden directories used by frontend tooling are
+// excluded from the key.
+func frontendBuildKey(frontendDir string, buildCommand string, outputDirs []string) (string, error) {
+	key := newCacheKey()
+	key.addString("command", buildCommand)
+	skipDir := func(path string, name string) bool {
+		if name == "node_modules" || strings.HasPrefix(name, ".") {
+			return true
+		}
+		for _, outputDir := range outputDirs {
+			if path == outputDir {
+				return true
+			}
+		}
+		return false
+	}
+	err := key.addDir(frontendDir, skipDir, func(string) bool { return true })
+	if err != nil {
+		return "", err
+	}
+	return key.String(), nil
+}
+
+// goSourcesKey returns a cache key for the Go sources, go.mod and go.sum of the project
+// together with the given build settings. The frontend and build directories are not included.
+// Packages the project depends on that live outside of it, EG: in a workspace module or a module
+// replaced by a local directory, are included too. An empty key is returned if the packages of the
+// project can't be listed, so the step is not cached.
+func goSourcesKey(projectData *project.Project, settings map[string]string) (string, error) {
+	key := newCacheKey()
+	for _, name := range sortedKeys(settings) {
+		key.addString(name, settings[name])
+	}
+	projectDir := projectData.Path
+	for _, filename := range []string{"go.mod", "go.sum"} {
+		if err := key.addFile(filename, filepath.Join(projectDir, filename)); err != nil {
+			return "", err
+		}
+	}
+	frontendDir := projectData.GetFrontendDir()
+	buildDir := projectData.GetBuildDir()
+	skipDir := func(path string, name string) bool {
+		return path == frontendDir || path == buildDir || name == "node_modules" || strings.HasPrefix(name, ".")
+	}
+	isGoFile := func(path string) bool {
+		return strings.HasSuffix(path, ".go")
+	}
+	err := key.addDir(projectDir, skipDir, isGoFile)
+	if err != nil {
+		return "", err
+	}
+
+	packageDirs, err := localPackageDirs(projectDir, settings["tags"])
+	if err != nil {
+		return "", nil
+	}
+	for _, packageDir := range packageDirs {
+		if packageDir == projectDir || strings.HasPrefix(packageDir, projectDir+string(filepath.Separator)) {
+			continue
+		}
+		// Only the package itself, its sub directories are separate packages
+		skipSubDirs := func(string, string) bool { return true }
+		key.addString("package", packageDir)
+		if err := key.addDir(packageDir, skipSubDirs, isGoFile); err != nil {
+			return "", err
+		}
+	}
+	return key.String(), nil
+}
+
+// localPackageDirs returns the directories of the packages the project depends on that are not
+// in the module cache: the packages of the main modules and of modules replaced by local directories
+func localPackageDirs(projectDir string, tags string) ([]string, error) {
+	// -mod=readonly stops go list from updating go.mod, which is part of the key
+	args := []string{"list", "-mod=readonly", "-deps", "-e", "-f", "{{if and .Module (or .Module.Main (and .Module.Replace (not .Module.Replace.Version)))}}{{.Dir}}{{end}}"}
+	if tags != "" {
+		args = append(args, "-tags", tags)
+	}
+	args = append(args, "./...")
+	stdout, stderr, err := shell.RunCommand(projectDir, "go", args...)
+	if err != nil {
+		return nil, fmt.Errorf("%s\n%s", err, stderr)
+	}
+	dirs := lo.Compact(lo.Uniq(strings.Split(strings.TrimSpace(stdout), "\n")))
+	sort.Strings(dirs)
+	return dirs, nil
+}
+
+func sortedKeys(values map[string]string) []string {
+	result := lo.Keys(values)
+	sort.Strings(result)
+	return result
+}
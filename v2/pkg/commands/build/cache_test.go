@@ -0,0 +1,123 @@
+package build
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/matryer/is"
+	"github.com/wailsapp/wails/v2/internal/project"
+)
+
+func writeTestFile(t *testing.T, filename string, content string) {
+	t.Helper()
+	if err := os.MkdirAll(filepath.Dir(filename), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filename, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestBuildCache(t *testing.T) {
+	is2 := is.New(t)
+
+	projectData := &project.Project{Path: t.TempDir(), BuildDir: "build"}
+	cache := newBuildCache(&Options{ProjectData: projectData})
+
+	is2.True(!cache.upToDate(cacheStepBindings, "key"))
+	is2.NoErr(cache.store(cacheStepBindings, "key"))
+	is2.True(cache.upToDate(cacheStepBindings, "key"))
+	is2.True(!cache.upToDate(cacheStepBindings, "other"))
+
+	cache.invalidate(cacheStepBindings)
+	is2.True(!cache.upToDate(cacheStepBindings, "key"))
+
+	// Bypassing the cache still records the keys for the next build
+	noCache := newBuildCache(&Options{ProjectData: projectData, NoCache: true})
+	is2.NoErr(noCache.store(cacheStepBindings, "key"))
+	is2.True(!noCache.upToDate(cacheStepBindings, "key"))
+	is2.True(cache.upToDate(cacheStepBindings, "key"))
+
+	is2.NoErr(CleanCache(projectData))
+	is2.True(!cache.upToDate(cacheStepBindings, "key"))
+}
+
+func TestFrontendBuildKey(t *testing.T) {
+	is2 := is.New(t)
+
+	frontendDir := t.TempDir()
+	distDir := filepath.Join(frontendDir, "dist")
+	writeTestFile(t, filepath.Join(frontendDir, "package.json"), "{}")
+	writeTestFile(t, filepath.Join(frontendDir, "src", "main.js"), "console.log('hello')")
+	writeTestFile(t, filepath.Join(distDir, "index.html"), "<html></html>")
+
+	key, err := frontendBuildKey(frontendDir, "npm run build", []string{distDir})
+	is2.NoErr(err)
+
+	// Output directories and node_modules don't change the key
+	writeTestFile(t, filepath.Join(distDir, "index.html"), "<html>changed</html>")
+	writeTestFile(t, filepath.Join(frontendDir, "node_modules", "dep", "index.js"), "")
+	unchanged, err := frontendBuildKey(frontendDir, "npm run build", []string{distDir})
+	is2.NoErr(err)
+	is2.Equal(key, unchanged)
+
+	// Sources and the build command do
+	writeTestFile(t, filepath.Join(frontendDir, "src", "main.js"), "console.log('changed')")
+	changed, err := frontendBuildKey(frontendDir, "npm run build", []string{distDir})
+	is2.NoErr(err)
+	is2.True(key != changed)
+
+	otherCommand, err := frontendBuildKey(frontendDir, "npm run build:prod", []string{distDir})
+	is2.NoErr(err)
+	is2.True(changed != otherCommand)
+}
+
+func TestGoSourcesKey(t *testing.T) {
+	is2 := is.New(t)
+
+	projectDir := t.TempDir()
+	projectData := &project.Project{Path: projectDir, FrontendDir: "frontend", BuildDir: "build"}
+	writeTestFile(t, filepath.Join(projectDir, "go.mod"), "module test")
+	writeTestFile(t, filepath.Join(projectDir, "main.go"), "package main")
+
+	key, err := goSourcesKey(projectData, map[string]string{"tags": ""})
+	is2.NoErr(err)
+
+	writeTestFile(t, filepath.Join(projectDir, "frontend", "wailsjs", "go", "main", "App.js"), "")
+	writeTestFile(t, filepath.Join(projectDir, "README.md"), "")
+	unchanged, err := goSourcesKey(projectData, map[string]string{"tags": ""})
+	is2.NoErr(err)
+	is2.Equal(key, unchanged)
+
+	otherTags, err := goSourcesKey(projectData, map[string]string{"tags": "custom"})
+	is2.NoErr(err)
+	is2.True(key != otherTags)
+
+	writeTestFile(t, filepath.Join(projectDir, "app", "app.go"), "package app")
+	changed, err := goSourcesKey(projectData, map[string]string{"tags": ""})
+	is2.NoErr(err)
+	is2.True(key != changed)
+}
+
+func TestGoSourcesKey_ReplacedModule(t *testing.T) {
+	is2 := is.New(t)
+
+	root := t.TempDir()
+	projectDir := filepath.Join(root, "app")
+	libDir := filepath.Join(root, "lib")
+	projectData := &project.Project{Path: projectDir, FrontendDir: "frontend", BuildDir: "build"}
+	writeTestFile(t, filepath.Join(libDir, "go.mod"), "module example.com/lib\n\ngo 1.18\n")
+	writeTestFile(t, filepath.Join(libDir, "lib.go"), "package lib\n\ntype Person struct{}\n")
+	writeTestFile(t, filepath.Join(projectDir, "go.mod"), "module test\n\ngo 1.18\n\nrequire example.com/lib v0.0.0\n\nreplace example.com/lib => ../lib\n")
+	writeTestFile(t, filepath.Join(projectDir, "main.go"), "package main\n\nimport _ \"example.com/lib\"\n\nfunc main() {}\n")
+
+	key, err := goSourcesKey(projectData, map[string]string{"tags": ""})
+	is2.NoErr(err)
+	is2.True(key != "")
+
+	writeTestFile(t, filepath.Join(libDir, "lib.go"), "package lib\n\ntype Person struct{ Name string }\n")
+	changed, err := goSourcesKey(projectData, map[string]string{"tags": ""})
+	is2.NoErr(err)
+	is2.True(key != changed)
+}
@@ -0,0 +1,256 @@
+package build
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/google/shlex"
+	"github.com/pterm/pterm"
+
+	"github.com/wailsapp/wails/v2/internal/fs"
+	"github.com/wailsapp/wails/v2/internal/shell"
+)
+
+// HookStage indicates when a build hook is run
+type HookStage string
+
+const (
+	// PreBuild hooks run before the build
+	PreBuild HookStage = "pre"
+	// PostBuild hooks run after the build
+	PostBuild HookStage = "post"
+)
+
+// HookEvent describes the build a hook is run for
+type HookEvent struct {
+	Stage      HookStage
+	Identifier string // The hook key, EG: "windows/amd64", "windows/*", "*/*", "windows", "*" or "" for global hooks
+	Platform   string // The platform being built. Empty for global hooks
+	Arch       string // The architecture being built. Only set for build level hooks
+	Bin        string // Path to the compiled binary. Only set for build level post build hooks
+	Mode       Mode
+	Options    *Options
+}
+
+// Hook is a build hook implemented in Go. Hooks are registered in Options.PreBuildHooks and
+// Options.PostBuildHooks using the same keys as the hooks in the project file.
+type Hook interface {
+	// Run executes the hook. The context is cancelled when the hook timeout expires.
+	Run(ctx context.Context, event HookEvent) error
+}
+
+// HookFunc allows an ordinary function to be used as a Hook
+type HookFunc func(ctx context.Context, event HookEvent) error
+
+// Run calls f(ctx, event)
+func (f HookFunc) Run(ctx context.Context, event HookEvent) error {
+	return f(ctx, event)
+}
+
+// buildHookIdentifiers returns the keys of the hooks executed for a single build, in order
+func buildHookIdentifiers(platform string, arch string) []string {
+	return []string{platform + "/" + arch, platform + "/*", "*/*"}
+}
+
+// platformHookIdentifiers returns the keys of the hooks executed once for all builds of a platform, in order
+func platformHookIdentifiers(platform string) []string {
+	return []string{platform, "*"}
+}
+
+// globalHookIdentifiers returns the keys of the hooks executed once for all builds of all platforms
+func globalHookIdentifiers() []string {
+	return []string{""}
+}
+
+// Environment returns the WAILS_* environment variables made available to hook commands
+func (e HookEvent) Environment() []string {
+	wailsVersion := ""
+	if e.Options != nil {
+		wailsVersion = e.Options.WailsVersion
+	}
+	return []string{
+		"WAILS_PLATFORM=" + e.Platform,
+		"WAILS_ARCH=" + e.Arch,
+		"WAILS_BIN=" + e.Bin,
+		"WAILS_MODE=" + e.Mode.String(),
+		"WAILS_VERSION=" + wailsVersion,
+	}
+}
+
+func (e HookEvent) argReplacements() map[string]string {
+	result := map[string]string{}
+	if e.Platform != "" && e.Arch != "" {
+		result["${platform}"] = e.Platform + "/" + e.Arch
+	}
+	if e.Bin != "" {
+		result["${bin}"] = e.Bin
+	}
+	return result
+}
+
+// runBuildHooks runs the hooks registered for the given identifiers, in order
+func runBuildHooks(options *Options, event HookEvent, identifiers []string) error {
+	event.Mode = options.Mode
+	event.Options = options
+	for _, identifier := range identifiers {
+		event.Identifier = identifier
+		if err := runBuildHook(options, event); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// runSingleBuildHooks runs the global, platform and build hooks for a build of a single target.
+// Pre build hooks run from the global to the build hooks, post build hooks in reverse, matching
+// the order used when building multiple targets.
+func runSingleBuildHooks(options *Options, event HookEvent) error {
+	global := HookEvent{Stage: event.Stage}
+	platform := HookEvent{Stage: event.Stage, Platform: event.Platform}
+	steps := []struct {
+		event       HookEvent
+		identifiers []string
+	}{
+		{global, globalHookIdentifiers()},
+		{platform, platformHookIdentifiers(event.Platform)},
+		{event, buildHookIdentifiers(event.Platform, event.Arch)},
+	}
+	if event.Stage == PostBuild {
+		for i, j := 0, len(steps)-1; i < j; i, j = i+1, j-1 {
+			steps[i], steps[j] = steps[j], steps[i]
+		}
+	}
+	for _, step := range steps {
+		if err := runBuildHooks(options, step.event, step.identifiers); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func runBuildHook(options *Options, event HookEvent) error {
+	commands := options.ProjectData.PreBuildHooks
+	goHooks := options.PreBuildHooks
+	if event.Stage == PostBuild {
+		commands = options.ProjectData.PostBuildHooks
+		goHooks = options.PostBuildHooks
+	}
+
+	command := commands[event.Identifier]
+	goHook := goHooks[event.Identifier]
+	if command == "" && goHook == nil {
+		return nil
+	}
+
+	ctx := context.Background()
+	timeout := options.hookTimeout()
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	if command != "" {
+		if err := executeBuildHook(ctx, options, event, command); err != nil {
+			return err
+		}
+	}
+
+	if goHook != nil {
+		fprintBulletPoint(options.Output, "Executing %s build hook '%s' (Go): ", event.Stage, event.Identifier)
+		err := goHook.Run(ctx, event)
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			return fmt.Errorf("%s build hook '%s' timed out after %s", event.Stage, event.Identifier, timeout)
+		}
+		if err != nil {
+			return fmt.Errorf("%s build hook '%s' failed: %w", event.Stage, event.Identifier, err)
+		}
+		pterm.Fprintln(options.Output, "Done.")
+	}
+
+	return nil
+}
+
+func (o *Options) hookTimeout() time.Duration {
+	if o.HookTimeout > 0 {
+		return o.HookTimeout
+	}
+	return time.Duration(o.ProjectData.BuildHookTimeout) * time.Second
+}
+
+func isNativeBuildHook(hookIdentifier string) bool {
+	if hookIdentifier == "" {
+		// That's the global hook
+		return true
+	}
+	platformOfHook := strings.Split(hookIdentifier, "/")[0]
+	// A hook without a specific platform or a hook for the host platform
+	return platformOfHook == "*" || platformOfHook == runtime.GOOS
+}
+
+func executeBuildHook(ctx context.Context, options *Options, event HookEvent, buildHook string) error {
+	if !options.ProjectData.RunNonNativeBuildHooks && !isNativeBuildHook(event.Identifier) {
+		// Skip a hook which is not native
+		fprintBulletPoint(options.Output, fmt.Sprintf("Non native build hook '%s': Skipping.", event.Identifier))
+		return nil
+	}
+
+	fprintBulletPoint(options.Output, "Executing %s build hook '%s': ", event.Stage, event.Identifier)
+	args, err := shlex.Split(buildHook)
+	if err != nil {
+		return fmt.Errorf("could not parse %s build hook command: %w", event.Stage, err)
+	}
+	if len(args) == 0 {
+		return fmt.Errorf("%s build hook '%s' has no command", event.Stage, event.Identifier)
+	}
+	argReplacements := event.argReplacements()
+	for i, arg := range args {
+		newArg := argReplacements[arg]
+		if newArg == "" {
+			continue
+		}
+		args[i] = newArg
+	}
+
+	if options.Verbosity == VERBOSE {
+		pterm.Info.WithWriter(options.Output).Println(strings.Join(args, " "))
+
+	}
+
+	if !fs.DirExists(options.BinDirectory) {
+		if err := fs.MkDirs(options.BinDirectory); err != nil {
+			return fmt.Errorf("could not create target directory: %s", err.Error())
+		}
+	}
+
+	cmd := exec.CommandContext(ctx, args[0], args[1:]...)
+	cmd.Dir = options.BinDirectory
+	cmd.Env = os.Environ()
+	for _, variable := range event.Environment() {
+		name, value, _ := strings.Cut(variable, "=")
+		cmd.Env = shell.SetEnv(cmd.Env, name, value)
+	}
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	err = cmd.Run()
+	if options.Verbosity == VERBOSE {
+		pterm.Info.WithWriter(options.Output).Println(stdout.String())
+	}
+	if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return fmt.Errorf("%s build hook '%s' timed out after %s", event.Stage, event.Identifier, options.hookTimeout())
+	}
+	if err != nil {
+		return fmt.Errorf("%s - %s", err.Error(), stderr.String())
+	}
+	pterm.Fprintln(options.Output, "Done.")
+
+	return nil
+}
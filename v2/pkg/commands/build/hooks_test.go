@@ -0,0 +1,121 @@
+package build
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/matryer/is"
+	"github.com/pterm/pterm"
+	"github.com/wailsapp/wails/v2/internal/project"
+)
+
+func TestRunBuildHooks(t *testing.T) {
+	is2 := is.New(t)
+
+	var executed []string
+	record := HookFunc(func(_ context.Context, event HookEvent) error {
+		executed = append(executed, string(event.Stage)+":"+event.Identifier)
+		return nil
+	})
+	options := &Options{
+		Mode:        Production,
+		ProjectData: &project.Project{},
+		PreBuildHooks: map[string]Hook{
+			"":              record,
+			"windows":       record,
+			"*":             record,
+			"windows/amd64": record,
+			"*/*":           record,
+		},
+	}
+
+	is2.NoErr(runBuildHooks(options, HookEvent{Stage: PreBuild}, globalHookIdentifiers()))
+	is2.NoErr(runBuildHooks(options, HookEvent{Stage: PreBuild, Platform: "windows"}, platformHookIdentifiers("windows")))
+	is2.NoErr(runBuildHooks(options, HookEvent{Stage: PreBuild, Platform: "windows", Arch: "amd64"}, buildHookIdentifiers("windows", "amd64")))
+	is2.NoErr(runBuildHooks(options, HookEvent{Stage: PostBuild}, globalHookIdentifiers()))
+
+	is2.Equal(strings.Join(executed, ","), "pre:,pre:windows,pre:*,pre:windows/amd64,pre:*/*")
+}
+
+func TestRunBuildHooksOutput(t *testing.T) {
+	is2 := is.New(t)
+
+	var output bytes.Buffer
+	options := &Options{
+		ProjectData: &project.Project{},
+		PostBuildHooks: map[string]Hook{
+			"linux/amd64": HookFunc(func(context.Context, HookEvent) error { return nil }),
+		},
+		Output: &output,
+	}
+
+	// The output of a target is written to its Output, so it can be buffered while building concurrently
+	is2.NoErr(runBuildHooks(options, HookEvent{Stage: PostBuild, Platform: "linux", Arch: "amd64"}, buildHookIdentifiers("linux", "amd64")))
+	is2.True(strings.Contains(pterm.RemoveColorFromString(output.String()), "Executing post build hook 'linux/amd64' (Go): Done."))
+}
+
+func TestRunSingleBuildHooks(t *testing.T) {
+	is2 := is.New(t)
+
+	var executed []string
+	record := HookFunc(func(_ context.Context, event HookEvent) error {
+		executed = append(executed, string(event.Stage)+":"+event.Identifier+":"+event.Platform+"/"+event.Arch)
+		return nil
+	})
+	hooks := map[string]Hook{
+		"":            record,
+		"linux":       record,
+		"linux/amd64": record,
+	}
+	options := &Options{
+		ProjectData:    &project.Project{},
+		PreBuildHooks:  hooks,
+		PostBuildHooks: hooks,
+	}
+
+	is2.NoErr(runSingleBuildHooks(options, HookEvent{Stage: PreBuild, Platform: "linux", Arch: "amd64"}))
+	is2.NoErr(runSingleBuildHooks(options, HookEvent{Stage: PostBuild, Platform: "linux", Arch: "amd64"}))
+
+	is2.Equal(strings.Join(executed, ","), "pre::/,pre:linux:linux/,pre:linux/amd64:linux/amd64,post:linux/amd64:linux/amd64,post:linux:linux/,post::/")
+}
+
+func TestRunBuildHooksTimeout(t *testing.T) {
+	is2 := is.New(t)
+
+	options := &Options{
+		HookTimeout: 10 * time.Millisecond,
+		ProjectData: &project.Project{},
+		PostBuildHooks: map[string]Hook{
+			"*/*": HookFunc(func(ctx context.Context, _ HookEvent) error {
+				<-ctx.Done()
+				return ctx.Err()
+			}),
+		},
+	}
+
+	err := runBuildHooks(options, HookEvent{Stage: PostBuild, Platform: "linux", Arch: "amd64"}, buildHookIdentifiers("linux", "amd64"))
+	is2.True(err != nil)
+	is2.True(strings.Contains(err.Error(), "timed out"))
+}
+
+func TestHookEvent_Environment(t *testing.T) {
+	is2 := is.New(t)
+
+	event := HookEvent{
+		Platform: "darwin",
+		Arch:     "universal",
+		Bin:      "/tmp/app",
+		Mode:     Debug,
+		Options:  &Options{WailsVersion: "v2.6.0"},
+	}
+	is2.Equal(event.Environment(), []string{
+		"WAILS_PLATFORM=darwin",
+		"WAILS_ARCH=universal",
+		"WAILS_BIN=/tmp/app",
+		"WAILS_MODE=debug",
+		"WAILS_VERSION=v2.6.0",
+	})
+}
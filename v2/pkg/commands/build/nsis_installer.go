@@ -10,6 +10,7 @@ import (
 	"github.com/wailsapp/wails/v2/internal/shell"
 	"github.com/wailsapp/wails/v2/internal/webview2runtime"
 	"github.com/wailsapp/wails/v2/pkg/buildassets"
+	"github.com/wailsapp/wails/v2/pkg/commands/sbom"
 )
 
 const (
@@ -49,6 +50,12 @@ func GenerateNSISInstaller(options *Options, amd64Binary string, arm64Binary str
 	if err := webview2runtime.WriteInstallerToFile(webviewSetup); err != nil {
 		return fmt.Errorf("Unable to write WebView2 Bootstrapper Setup: %w", err)
 	}
+	if options.Reproducible {
+		// The installer stores the modification times of the files it installs
+		if err := normaliseTimestamps(options, webviewSetup); err != nil {
+			return err
+		}
+	}
 
 	if !shell.CommandExists("makensis") {
 		outputLogger.Println("Warning: Cannot create installer: makensis not found")
@@ -99,6 +106,14 @@ func makeNSIS(options *Options, installerKind string, amd64Binary string, arm64B
 	if arm64Binary != "" {
 		args = append(args, "-DARG_WAILS_ARM64_BINARY="+arm64Binary)
 	}
+	if notice := noticeFile(amd64Binary, arm64Binary); notice != "" {
+		args = append(args, "-DARG_WAILS_NOTICE_FILE="+notice)
+		if options.Reproducible {
+			if err := normaliseTimestamps(options, notice); err != nil {
+				return err
+			}
+		}
+	}
 	args = append(args, nsisProjectFile)
 
 	if verbose {
@@ -114,6 +129,48 @@ func makeNSIS(options *Options, installerKind string, amd64Binary string, arm64B
 	if err != nil {
 		return fmt.Errorf("Error during creation of the installer: %w", err)
 	}
+	if options.Reproducible {
+		installer := installerOutputFile(installerDir, stdOut)
+		if installer == "" {
+			return fmt.Errorf("Unable to find the installer in the output of makensis")
+		}
+		if err := normaliseInstaller(options, installer); err != nil {
+			return err
+		}
+	}
 	outputLogger.Println("Done.")
 	return nil
 }
+
+// installerOutputFile returns the installer written by makensis, as printed in its output
+// in the form `Output: "C:\path\to\installer.exe"`. Relative paths are relative to the
+// directory of the project file.
+func installerOutputFile(installerDir string, stdOut string) string {
+	for _, line := range strings.Split(stdOut, "\n") {
+		line = strings.TrimSpace(line)
+		if !strings.HasPrefix(line, "Output: \"") {
+			continue
+		}
+		filename := strings.Trim(strings.TrimPrefix(line, "Output:"), " \"")
+		if !filepath.IsAbs(filename) {
+			filename = filepath.Join(installerDir, filename)
+		}
+		return filename
+	}
+	return ""
+}
+
+// noticeFile returns the absolute path of the third-party license notice generated next to
+// the first of the given binaries, or an empty string if there is none
+func noticeFile(binaries ...string) string {
+	for _, binary := range binaries {
+		if binary == "" {
+			continue
+		}
+		notice, err := filepath.Abs(filepath.Join(filepath.Dir(binary), sbom.NoticeFilename))
+		if err == nil && fs.FileExists(notice) {
+			return notice
+		}
+	}
+	return ""
+}
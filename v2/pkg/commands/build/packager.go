@@ -66,12 +66,7 @@ func packageApplicationForDarwin(options *Options) error {
 	var err error
 
 	// Create directory structure
-	bundlename := options.BundleName
-	if bundlename == "" {
-		bundlename = options.ProjectData.Name + ".app"
-	}
-
-	contentsDirectory := filepath.Join(options.BinDirectory, bundlename, "/Contents")
+	contentsDirectory := filepath.Join(darwinBundleDirectory(options), "/Contents")
 	exeDir := filepath.Join(contentsDirectory, "/MacOS")
 	err = fs.MkDirs(exeDir, 0755)
 	if err != nil {
@@ -106,6 +101,15 @@ func packageApplicationForDarwin(options *Options) error {
 	return nil
 }
 
+// darwinBundleDirectory returns the path of the .app bundle
+func darwinBundleDirectory(options *Options) string {
+	bundlename := options.BundleName
+	if bundlename == "" {
+		bundlename = options.ProjectData.Name + ".app"
+	}
+	return filepath.Join(options.BinDirectory, bundlename)
+}
+
 func processPList(options *Options, contentsDirectory string) error {
 
 	sourcePList := "Info.plist"
@@ -201,18 +205,7 @@ func generateIcoFile(options *Options) error {
 
 func compileResources(options *Options) error {
 
-	currentDir, err := os.Getwd()
-	if err != nil {
-		return err
-	}
-	defer func() {
-		os.Chdir(currentDir)
-	}()
 	windowsDir := filepath.Join(options.ProjectData.GetBuildDir(), "windows")
-	err = os.Chdir(windowsDir)
-	if err != nil {
-		return err
-	}
 	rs := winres.ResourceSet{}
 	icon := filepath.Join(windowsDir, "icon.ico")
 	iconFile, err := os.Open(icon)
@@ -253,7 +246,7 @@ func compileResources(options *Options) error {
 		rs.SetVersionInfo(v)
 	}
 
-	targetFile := filepath.Join(options.ProjectData.Path, options.ProjectData.Name+"-res.syso")
+	targetFile := windowsResourceFile(options)
 	fout, err := os.Create(targetFile)
 	if err != nil {
 		return err
@@ -276,3 +269,10 @@ func compileResources(options *Options) error {
 	}
 	return nil
 }
+
+// windowsResourceFile returns the path of the syso file for the target architecture.
+// The GOOS_GOARCH suffix lets the Go toolchain pick the right file when several
+// windows targets are built at the same time.
+func windowsResourceFile(options *Options) string {
+	return filepath.Join(options.ProjectData.Path, fmt.Sprintf("%s-res_windows_%s.syso", options.ProjectData.Name, options.Arch))
+}
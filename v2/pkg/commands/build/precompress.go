@@ -0,0 +1,157 @@
+package build
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	iofs "io/fs"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/wailsapp/wails/v2/internal/staticanalysis"
+)
+
+// precompressMinSize is the size below which assets are not worth compressing
+const precompressMinSize = 1024
+
+// precompressExtensions are the extensions of assets that compress well
+var precompressExtensions = []string{
+	".html", ".htm", ".js", ".mjs", ".cjs", ".css", ".json", ".map", ".svg", ".txt", ".xml", ".wasm", ".ico", ".ttf", ".otf",
+}
+
+// precompressAssets writes gzip and brotli compressed siblings, EG: `main.js.gz` and `main.js.br`,
+// of the assets in the embedded directories of the project. The asset server serves these
+// to clients that accept the encoding. Brotli siblings are only written if the path of the
+// brotli command is given.
+func precompressAssets(options *Options, brotli string) (int, error) {
+	embedDetails, err := staticanalysis.GetEmbedDetails(options.ProjectData.Path)
+	if err != nil {
+		return 0, err
+	}
+
+	count := 0
+	for _, embedDetail := range embedDetails {
+		dir := embedDetail.GetFullPath()
+		if info, err := os.Stat(dir); err != nil || !info.IsDir() {
+			continue
+		}
+		compressed, err := precompressDirectory(dir, brotli)
+		if err != nil {
+			return count, err
+		}
+		count += compressed
+	}
+	return count, nil
+}
+
+// precompressDirectory compresses the assets in the given directory and returns the number of
+// assets compressed. Brotli siblings are only written if the path of the brotli command is given.
+func precompressDirectory(dir string, brotli string) (int, error) {
+	count := 0
+	err := filepath.WalkDir(dir, func(path string, entry iofs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if entry.IsDir() || !shouldPrecompress(path) {
+			return nil
+		}
+		info, err := entry.Info()
+		if err != nil {
+			return err
+		}
+		if info.Size() < precompressMinSize {
+			return nil
+		}
+
+		written, err := writeGzipSibling(path, info)
+		if err != nil {
+			return err
+		}
+		if brotli != "" {
+			brotliWritten, err := writeBrotliSibling(brotli, path, info)
+			if err != nil {
+				return err
+			}
+			written = written || brotliWritten
+		}
+		if written {
+			count++
+		}
+		return nil
+	})
+	return count, err
+}
+
+func shouldPrecompress(path string) bool {
+	extension := strings.ToLower(filepath.Ext(path))
+	for _, candidate := range precompressExtensions {
+		if extension == candidate {
+			return true
+		}
+	}
+	return false
+}
+
+// siblingUpToDate returns true if the sibling was written after the asset was last modified
+func siblingUpToDate(sibling string, info iofs.FileInfo) bool {
+	siblingInfo, err := os.Stat(sibling)
+	return err == nil && !siblingInfo.ModTime().Before(info.ModTime())
+}
+
+// writeSibling writes the compressed data next to the asset if it is smaller than the asset.
+// A stale sibling is removed otherwise so that it isn't served instead of the asset.
+func writeSibling(sibling string, data []byte, info iofs.FileInfo) (bool, error) {
+	if int64(len(data)) >= info.Size() {
+		if err := os.Remove(sibling); err != nil && !os.IsNotExist(err) {
+			return false, err
+		}
+		return false, nil
+	}
+	return true, os.WriteFile(sibling, data, 0644)
+}
+
+func writeGzipSibling(path string, info iofs.FileInfo) (bool, error) {
+	sibling := path + ".gz"
+	if siblingUpToDate(sibling, info) {
+		return false, nil
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return false, err
+	}
+	defer file.Close()
+
+	// The gzip header has no name or modification time so that the output is reproducible
+	var buffer bytes.Buffer
+	writer, err := gzip.NewWriterLevel(&buffer, gzip.BestCompression)
+	if err != nil {
+		return false, err
+	}
+	if _, err := io.Copy(writer, file); err != nil {
+		return false, err
+	}
+	if err := writer.Close(); err != nil {
+		return false, err
+	}
+	return writeSibling(sibling, buffer.Bytes(), info)
+}
+
+func writeBrotliSibling(brotli string, path string, info iofs.FileInfo) (bool, error) {
+	sibling := path + ".br"
+	if siblingUpToDate(sibling, info) {
+		return false, nil
+	}
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.Command(brotli, "--quality=11", "--stdout", path)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return false, fmt.Errorf("unable to compress '%s' with brotli: %w - %s", path, err, stderr.String())
+	}
+	return writeSibling(sibling, stdout.Bytes(), info)
+}
@@ -0,0 +1,55 @@
+package build
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/matryer/is"
+)
+
+func TestPrecompressDirectory(t *testing.T) {
+	is2 := is.New(t)
+
+	dir := t.TempDir()
+	script := []byte(strings.Repeat("console.log('wails');\n", 100))
+	is2.NoErr(os.MkdirAll(filepath.Join(dir, "assets"), 0755))
+	is2.NoErr(os.WriteFile(filepath.Join(dir, "assets", "main.js"), script, 0644))
+	is2.NoErr(os.WriteFile(filepath.Join(dir, "small.css"), []byte("body{}"), 0644))
+	is2.NoErr(os.WriteFile(filepath.Join(dir, "image.png"), bytes.Repeat([]byte{0}, 4096), 0644))
+
+	count, err := precompressDirectory(dir, "")
+	is2.NoErr(err)
+	is2.Equal(count, 1)
+
+	file, err := os.Open(filepath.Join(dir, "assets", "main.js.gz"))
+	is2.NoErr(err)
+	defer file.Close()
+	reader, err := gzip.NewReader(file)
+	is2.NoErr(err)
+	content, err := io.ReadAll(reader)
+	is2.NoErr(err)
+	is2.Equal(content, script)
+
+	_, err = os.Stat(filepath.Join(dir, "small.css.gz"))
+	is2.True(os.IsNotExist(err)) // Too small to be worth compressing
+	_, err = os.Stat(filepath.Join(dir, "image.png.gz"))
+	is2.True(os.IsNotExist(err)) // Already compressed formats are skipped
+
+	// Up to date siblings are not written again
+	count, err = precompressDirectory(dir, "")
+	is2.NoErr(err)
+	is2.Equal(count, 0)
+
+	// Modified assets are compressed again
+	later := time.Now().Add(time.Minute)
+	is2.NoErr(os.Chtimes(filepath.Join(dir, "assets", "main.js"), later, later))
+	count, err = precompressDirectory(dir, "")
+	is2.NoErr(err)
+	is2.Equal(count, 1)
+}
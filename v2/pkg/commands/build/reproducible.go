@@ -0,0 +1,144 @@
+package build
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/wailsapp/wails/v2/internal/buildinfo"
+	"github.com/wailsapp/wails/v2/internal/shell"
+)
+
+// gitCommit returns the commit hash of the project's git checkout, suffixed with
+// "-dirty" if there are uncommitted changes. An empty string is returned if the
+// project is not in a git repository.
+func gitCommit(projectDir string) string {
+	stdout, _, err := shell.RunCommand(projectDir, "git", "rev-parse", "HEAD")
+	if err != nil {
+		return ""
+	}
+	commit := strings.TrimSpace(stdout)
+	status, _, err := shell.RunCommand(projectDir, "git", "status", "--porcelain")
+	if err == nil && strings.TrimSpace(status) != "" {
+		commit += "-dirty"
+	}
+	return commit
+}
+
+// sourceDateEpoch returns the time used for all timestamps of a reproducible build.
+// SOURCE_DATE_EPOCH is used if set, otherwise the time of the last git commit.
+func sourceDateEpoch(projectDir string) (time.Time, error) {
+	value := os.Getenv("SOURCE_DATE_EPOCH")
+	if value == "" {
+		stdout, _, err := shell.RunCommand(projectDir, "git", "log", "-1", "--format=%ct")
+		if err != nil {
+			return time.Time{}, fmt.Errorf("reproducible builds require SOURCE_DATE_EPOCH to be set or the project to be in a git repository")
+		}
+		value = strings.TrimSpace(stdout)
+	}
+	seconds, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid SOURCE_DATE_EPOCH '%s': %w", value, err)
+	}
+	return time.Unix(seconds, 0).UTC(), nil
+}
+
+// buildInfo returns the build information embedded into the application
+func (o *Options) buildInfo(tags []string) (buildinfo.Info, error) {
+	buildTime := time.Now().UTC()
+	if o.Reproducible {
+		epoch, err := sourceDateEpoch(o.ProjectData.Path)
+		if err != nil {
+			return buildinfo.Info{}, err
+		}
+		buildTime = epoch
+	}
+
+	return buildinfo.Info{
+		AppVersion:   o.ProjectData.Info.ProductVersion,
+		GitCommit:    gitCommit(o.ProjectData.Path),
+		BuildTime:    buildTime.Format(time.RFC3339),
+		WailsVersion: o.WailsVersion,
+		Tags:         tags,
+		Reproducible: o.Reproducible,
+	}, nil
+}
+
+// normaliseTimestamps sets the modification time of the given file, or of all files
+// in the given directory, to the source date epoch.
+//
+// The NSIS installer stores the modification times of the files it installs, so these
+// are normalised before it is created. See normaliseInstaller.
+func normaliseTimestamps(options *Options, path string) error {
+	epoch, err := sourceDateEpoch(options.ProjectData.Path)
+	if err != nil {
+		return err
+	}
+	// Directories are updated after their contents as writing a file changes
+	// the modification time of the directory it is in
+	var directories []string
+	err = filepath.Walk(path, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			directories = append(directories, path)
+			return nil
+		}
+		return os.Chtimes(path, epoch, epoch)
+	})
+	if err != nil {
+		return err
+	}
+	for index := len(directories) - 1; index >= 0; index-- {
+		if err := os.Chtimes(directories[index], epoch, epoch); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// normaliseInstaller sets the link time in the PE header of the given Windows executable,
+// and its modification time, to the source date epoch. makensis sets the link time of
+// installers to the time they were created.
+func normaliseInstaller(options *Options, filename string) error {
+	epoch, err := sourceDateEpoch(options.ProjectData.Path)
+	if err != nil {
+		return err
+	}
+	if err := setPETimestamp(filename, epoch); err != nil {
+		return err
+	}
+	return os.Chtimes(filename, epoch, epoch)
+}
+
+// setPETimestamp sets the TimeDateStamp of the COFF header of the given Windows executable
+func setPETimestamp(filename string, timestamp time.Time) error {
+	file, err := os.OpenFile(filename, os.O_RDWR, 0)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	// The offset of the PE signature is stored at 0x3c of the MS-DOS stub
+	var offset [4]byte
+	if _, err := file.ReadAt(offset[:], 0x3c); err != nil {
+		return fmt.Errorf("%s is not a Windows executable: %w", filename, err)
+	}
+	signatureOffset := int64(binary.LittleEndian.Uint32(offset[:]))
+	var signature [4]byte
+	if _, err := file.ReadAt(signature[:], signatureOffset); err != nil || !bytes.Equal(signature[:], []byte("PE\x00\x00")) {
+		return fmt.Errorf("%s is not a Windows executable", filename)
+	}
+
+	// The TimeDateStamp follows the Machine and NumberOfSections fields of the COFF header
+	var stamp [4]byte
+	binary.LittleEndian.PutUint32(stamp[:], uint32(timestamp.Unix()))
+	_, err = file.WriteAt(stamp[:], signatureOffset+4+4)
+	return err
+}
@@ -0,0 +1,57 @@
+package build
+
+import (
+	"debug/pe"
+	"encoding/binary"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/matryer/is"
+)
+
+func TestSetPETimestamp(t *testing.T) {
+	is2 := is.New(t)
+
+	// An MS-DOS stub pointing at a PE signature and an empty COFF header for amd64
+	data := make([]byte, 0x40)
+	binary.LittleEndian.PutUint32(data[0x3c:], 0x40)
+	data = append(data, 'P', 'E', 0, 0)
+	header := make([]byte, 20)
+	binary.LittleEndian.PutUint16(header, pe.IMAGE_FILE_MACHINE_AMD64)
+	binary.LittleEndian.PutUint32(header[4:], uint32(time.Now().Unix()))
+	data = append(data, header...)
+
+	filename := filepath.Join(t.TempDir(), "installer.exe")
+	is2.NoErr(os.WriteFile(filename, data, 0644))
+
+	timestamp := time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC)
+	is2.NoErr(setPETimestamp(filename, timestamp))
+
+	result, err := os.ReadFile(filename)
+	is2.NoErr(err)
+	is2.Equal(binary.LittleEndian.Uint16(result[0x44:]), uint16(pe.IMAGE_FILE_MACHINE_AMD64))
+	is2.Equal(binary.LittleEndian.Uint32(result[0x48:]), uint32(timestamp.Unix()))
+}
+
+func TestSetPETimestampNotAnExecutable(t *testing.T) {
+	is2 := is.New(t)
+
+	filename := filepath.Join(t.TempDir(), "installer.exe")
+	is2.NoErr(os.WriteFile(filename, make([]byte, 0x80), 0644))
+	is2.True(setPETimestamp(filename, time.Now()) != nil)
+}
+
+func TestInstallerOutputFile(t *testing.T) {
+	is2 := is.New(t)
+
+	installerDir := filepath.Join("project", "build", "windows", "installer")
+	absolute, err := filepath.Abs(filepath.Join("project", "build", "bin", "app-amd64-installer.exe"))
+	is2.NoErr(err)
+
+	stdOut := "Processed 1 file, writing output (x86-unicode):\n\nOutput: \"" + absolute + "\"\nInstall: 7 pages\n"
+	is2.Equal(installerOutputFile(installerDir, stdOut), absolute)
+	is2.Equal(installerOutputFile(installerDir, "Output: \"app-installer.exe\"\r\n"), filepath.Join(installerDir, "app-installer.exe"))
+	is2.Equal(installerOutputFile(installerDir, "Processed 1 file\n"), "")
+}
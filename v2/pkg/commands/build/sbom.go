@@ -0,0 +1,71 @@
+package build
+
+import (
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/pterm/pterm"
+
+	"github.com/wailsapp/wails/v2/pkg/commands/sbom"
+)
+
+// generateSBOM writes the bill of materials of the compiled application to the bin
+// directory and adds the third-party license notice next to the application, or into
+// the resources of the application bundle on macOS
+func generateSBOM(options *Options) error {
+	formatName := options.SBOMFormat
+	if formatName == "" {
+		formatName = options.ProjectData.SBOM.Format
+	}
+	format, err := sbom.ParseFormat(formatName)
+	if err != nil {
+		return err
+	}
+
+	timestamp := time.Now()
+	if options.Reproducible {
+		timestamp, err = sourceDateEpoch(options.ProjectData.Path)
+		if err != nil {
+			return err
+		}
+	}
+
+	report, err := sbom.Generate(sbom.Options{
+		AppName:           options.ProjectData.Name,
+		AppVersion:        options.ProjectData.Info.ProductVersion,
+		ProjectDirectory:  options.ProjectData.Path,
+		FrontendDirectory: options.ProjectData.GetFrontendDir(),
+		Binary:            options.CompiledBinary,
+		Timestamp:         timestamp,
+		WailsVersion:      options.WailsVersion,
+	})
+	if err != nil {
+		return err
+	}
+	for _, warning := range report.Warnings {
+		pterm.Warning.WithWriter(options.Output).Println(warning)
+	}
+
+	name := strings.TrimSuffix(filepath.Base(options.CompiledBinary), ".exe")
+	err = report.WriteFile(filepath.Join(options.BinDirectory, name+format.Extension()), format)
+	if err != nil {
+		return err
+	}
+
+	noticeDirectory := filepath.Dir(options.CompiledBinary)
+	if options.Pack && options.Platform == "darwin" {
+		noticeDirectory = filepath.Join(darwinBundleDirectory(options), "Contents", "Resources")
+	}
+	err = report.WriteNoticeFile(filepath.Join(noticeDirectory, sbom.NoticeFilename))
+	if err != nil {
+		return err
+	}
+
+	err = report.CheckLicenses(options.ProjectData.SBOM.DisallowedLicenses)
+	if err != nil && !options.ProjectData.SBOM.FailOnDisallowedLicense {
+		pterm.Warning.WithWriter(options.Output).Println(err.Error())
+		return nil
+	}
+	return err
+}
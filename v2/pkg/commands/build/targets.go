@@ -0,0 +1,295 @@
+package build
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/pterm/pterm"
+	"github.com/samber/lo"
+	"github.com/wailsapp/wails/v2/internal/fs"
+)
+
+// Target is a single platform/architecture combination to build
+type Target struct {
+	Platform   string // GOOS of the target
+	Arch       string // GOARCH of the target
+	OutputFile string // Optional output filename for this target
+}
+
+func (t Target) String() string {
+	return t.Platform + "/" + t.Arch
+}
+
+// TargetStatus indicates the outcome of building a single target
+type TargetStatus string
+
+const (
+	// TargetSucceeded means the target was built
+	TargetSucceeded TargetStatus = "success"
+	// TargetFailed means the target failed to build
+	TargetFailed TargetStatus = "failed"
+	// TargetCancelled means the target was not built because another target failed and FailFast was set
+	TargetCancelled TargetStatus = "cancelled"
+)
+
+// TargetResult holds the result of building a single target
+type TargetResult struct {
+	Target   Target
+	Status   TargetStatus
+	Binary   string        // Fully qualified path to the compiled binary
+	Size     int64         // Size of the compiled binary in bytes
+	Duration time.Duration // Time taken to compile and package the target
+	Err      error
+}
+
+// BuildTargets builds the project for all the given targets. The frontend and bindings
+// are built once, then the Go compilation for each target is run concurrently, limited
+// by options.Jobs. When more than one target is given, each target is written to its own
+// subdirectory of the bin directory. A failing target does not stop the other targets
+// unless options.FailFast is set.
+func BuildTargets(options *Options, targets []Target) ([]*TargetResult, error) {
+
+	builder, err := newBuilder(options)
+	if err != nil {
+		return nil, err
+	}
+	defer builder.CleanUp()
+
+	if options.CleanBinDirectory {
+		err = cleanBinDirectory(options)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	platforms := lo.Uniq(lo.Map(targets, func(target Target, _ int) string {
+		return target.Platform
+	}))
+
+	// Pre build hooks run before anything is built: global hooks first, then the
+	// platform hooks and finally the hooks of each build, in target order
+	if err := runBuildHooks(options, HookEvent{Stage: PreBuild}, globalHookIdentifiers()); err != nil {
+		return nil, err
+	}
+	for _, platform := range platforms {
+		hookEvent := HookEvent{Stage: PreBuild, Platform: platform}
+		if err := runBuildHooks(options, hookEvent, platformHookIdentifiers(platform)); err != nil {
+			return nil, err
+		}
+	}
+	for _, target := range targets {
+		hookEvent := HookEvent{Stage: PreBuild, Platform: target.Platform, Arch: target.Arch}
+		targetOptions := options.forTarget(target, options.BinDirectory)
+		if err := runBuildHooks(targetOptions, hookEvent, buildHookIdentifiers(target.Platform, target.Arch)); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := buildSharedAssets(builder, options); err != nil {
+		return nil, err
+	}
+
+	if options.IgnoreApplication {
+		return nil, nil
+	}
+
+	// go.mod is shared by all targets so we tidy it once, before any compilation starts
+	if !options.SkipModTidy {
+		err = runModTidy(options)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// The windows targets share the icon, so it is generated before they are built
+	if options.Pack && lo.Contains(platforms, "windows") {
+		if err := generateIcoFile(options); err != nil {
+			return nil, err
+		}
+	}
+
+	targetOptions := make([]*Options, len(targets))
+	for index, target := range targets {
+		binDirectory := options.BinDirectory
+		if len(targets) > 1 {
+			binDirectory = filepath.Join(options.BinDirectory, target.Platform+"_"+target.Arch)
+		}
+		targetOptions[index] = options.forTarget(target, binDirectory)
+	}
+
+	// The output of concurrent targets is buffered and written once each target is built,
+	// so the output of the targets is not interleaved
+	var outputLock sync.Mutex
+	results := buildConcurrently(targets, options.Jobs, options.FailFast, func(index int, result *TargetResult) {
+		if len(targets) == 1 {
+			buildTarget(targetOptions[index], result)
+			return
+		}
+		var output bytes.Buffer
+		pterm.DefaultSection.WithLevel(2).WithWriter(&output).Println(targets[index].String())
+		targetOptions[index].Output = &output
+		buildTarget(targetOptions[index], result)
+
+		outputLock.Lock()
+		defer outputLock.Unlock()
+		_, _ = output.WriteTo(options.commandOutput(os.Stdout))
+	})
+
+	// Platform post build hooks run once all builds of the platform succeeded
+	for _, platform := range platforms {
+		if !platformSucceeded(results, platform) {
+			continue
+		}
+		hookEvent := HookEvent{Stage: PostBuild, Platform: platform}
+		if err := runBuildHooks(options, hookEvent, platformHookIdentifiers(platform)); err != nil {
+			return results, err
+		}
+	}
+
+	if err := targetsError(results); err != nil {
+		return results, err
+	}
+
+	if err := runBuildHooks(options, HookEvent{Stage: PostBuild}, globalHookIdentifiers()); err != nil {
+		return results, err
+	}
+
+	return results, nil
+}
+
+// buildConcurrently builds each target by calling build with its index, running at most jobs
+// builds at a time. If jobs is less than 1, the number of CPUs is used. If failFast is set, the
+// targets that have not started when a target fails are cancelled.
+func buildConcurrently(targets []Target, jobs int, failFast bool, build func(index int, result *TargetResult)) []*TargetResult {
+	if jobs < 1 {
+		jobs = runtime.NumCPU()
+	}
+
+	results := make([]*TargetResult, len(targets))
+	semaphore := make(chan struct{}, jobs)
+	var failed int32
+	var wg sync.WaitGroup
+
+	for index, target := range targets {
+		result := &TargetResult{
+			Target: target,
+			Status: TargetCancelled,
+		}
+		results[index] = result
+
+		// The targets are started in order
+		wg.Add(1)
+		semaphore <- struct{}{}
+		go func(index int) {
+			defer wg.Done()
+			defer func() { <-semaphore }()
+
+			if failFast && atomic.LoadInt32(&failed) != 0 {
+				return
+			}
+
+			build(index, result)
+			if result.Err != nil {
+				atomic.StoreInt32(&failed, 1)
+			}
+		}(index)
+	}
+
+	wg.Wait()
+	return results
+}
+
+// platformSucceeded returns true if all targets of the platform were built
+func platformSucceeded(results []*TargetResult, platform string) bool {
+	for _, result := range results {
+		if result.Target.Platform == platform && result.Status != TargetSucceeded {
+			return false
+		}
+	}
+	return true
+}
+
+// targetsError returns an error with the number of targets that failed or were cancelled,
+// or nil if all targets were built
+func targetsError(results []*TargetResult) error {
+	failures := lo.CountBy(results, func(result *TargetResult) bool {
+		return result.Status != TargetSucceeded
+	})
+	if failures > 0 {
+		return fmt.Errorf("%d of %d targets failed to build", failures, len(results))
+	}
+	return nil
+}
+
+// forTarget returns a copy of the options that is safe to use for building the given
+// target alongside other targets
+func (o *Options) forTarget(target Target, binDirectory string) *Options {
+	result := *o
+	projectData := *o.ProjectData
+	result.ProjectData = &projectData
+	result.UserTags = append([]string{}, o.UserTags...)
+	result.Platform = target.Platform
+	result.Arch = target.Arch
+	result.OutputFile = target.OutputFile
+	result.BinDirectory = binDirectory
+	result.CompiledBinary = ""
+	result.CleanBinDirectory = false
+	result.IgnoreFrontend = true
+	result.SkipBindings = true
+	result.SkipModTidy = true
+	return &result
+}
+
+func buildTarget(options *Options, result *TargetResult) {
+	start := time.Now()
+	defer func() {
+		result.Duration = time.Since(start)
+		if result.Err != nil {
+			result.Status = TargetFailed
+			return
+		}
+		result.Status = TargetSucceeded
+	}()
+
+	builder, err := newBuilder(options)
+	if err != nil {
+		result.Err = err
+		return
+	}
+	defer builder.CleanUp()
+
+	if !fs.DirExists(options.BinDirectory) {
+		if err := fs.MkDirs(options.BinDirectory); err != nil {
+			result.Err = err
+			return
+		}
+	}
+
+	compiledBinary, err := execBuildApplication(builder, options)
+	if err != nil {
+		result.Err = err
+		return
+	}
+	result.Binary = compiledBinary
+
+	hookEvent := HookEvent{
+		Stage:    PostBuild,
+		Platform: result.Target.Platform,
+		Arch:     result.Target.Arch,
+		Bin:      compiledBinary,
+	}
+	if err := runBuildHooks(options, hookEvent, buildHookIdentifiers(result.Target.Platform, result.Target.Arch)); err != nil {
+		result.Err = err
+		return
+	}
+
+	if info, err := os.Stat(compiledBinary); err == nil {
+		result.Size = info.Size()
+	}
+}
@@ -0,0 +1,138 @@
+package build
+
+import (
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/wailsapp/wails/v2/internal/project"
+)
+
+func TestOptions_forTarget(t *testing.T) {
+	options := &Options{
+		UserTags:          []string{"custom"},
+		Platform:          "linux",
+		Arch:              "amd64",
+		BinDirectory:      "build/bin",
+		CleanBinDirectory: true,
+		ProjectData:       &project.Project{Name: "app"},
+	}
+	target := Target{Platform: "windows", Arch: "arm64", OutputFile: "app.exe"}
+
+	got := options.forTarget(target, "build/bin/windows_arm64")
+
+	if got.Platform != "windows" || got.Arch != "arm64" || got.OutputFile != "app.exe" {
+		t.Errorf("forTarget() target = %s/%s %s, want windows/arm64 app.exe", got.Platform, got.Arch, got.OutputFile)
+	}
+	if got.BinDirectory != "build/bin/windows_arm64" {
+		t.Errorf("forTarget() BinDirectory = %s, want build/bin/windows_arm64", got.BinDirectory)
+	}
+	if got.CleanBinDirectory || !got.IgnoreFrontend || !got.SkipBindings || !got.SkipModTidy {
+		t.Errorf("forTarget() should only compile the target")
+	}
+
+	// Changes to the copy must not leak into the shared options
+	got.UserTags = append(got.UserTags[:0], "obfuscated")
+	got.ProjectData.OutputFilename = "changed"
+	if options.UserTags[0] != "custom" {
+		t.Errorf("forTarget() shares UserTags with the original options")
+	}
+	if options.ProjectData.OutputFilename != "" {
+		t.Errorf("forTarget() shares ProjectData with the original options")
+	}
+	if options.Platform != "linux" || options.Arch != "amd64" {
+		t.Errorf("forTarget() modified the original options")
+	}
+}
+
+func TestTarget_String(t *testing.T) {
+	target := Target{Platform: "darwin", Arch: "universal"}
+	if got := target.String(); got != "darwin/universal" {
+		t.Errorf("String() = %v, want darwin/universal", got)
+	}
+}
+
+func TestBuildConcurrently_Jobs(t *testing.T) {
+	targets := []Target{
+		{Platform: "windows", Arch: "amd64"},
+		{Platform: "windows", Arch: "arm64"},
+		{Platform: "linux", Arch: "amd64"},
+		{Platform: "linux", Arch: "arm64"},
+		{Platform: "darwin", Arch: "universal"},
+	}
+	var running, maxRunning int32
+	results := buildConcurrently(targets, 2, false, func(index int, result *TargetResult) {
+		current := atomic.AddInt32(&running, 1)
+		for {
+			seen := atomic.LoadInt32(&maxRunning)
+			if current <= seen || atomic.CompareAndSwapInt32(&maxRunning, seen, current) {
+				break
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+		atomic.AddInt32(&running, -1)
+		result.Status = TargetSucceeded
+	})
+
+	if maxRunning > 2 {
+		t.Errorf("buildConcurrently() ran %d builds at a time, want at most 2", maxRunning)
+	}
+	for index, result := range results {
+		if result.Target != targets[index] {
+			t.Errorf("buildConcurrently() result %d is for %s, want %s", index, result.Target, targets[index])
+		}
+		if result.Status != TargetSucceeded {
+			t.Errorf("buildConcurrently() %s status = %s, want success", result.Target, result.Status)
+		}
+	}
+	if err := targetsError(results); err != nil {
+		t.Errorf("targetsError() = %v, want nil", err)
+	}
+}
+
+func TestBuildConcurrently_FailFast(t *testing.T) {
+	targets := []Target{
+		{Platform: "windows", Arch: "amd64"},
+		{Platform: "linux", Arch: "amd64"},
+		{Platform: "linux", Arch: "arm64"},
+	}
+	build := func(index int, result *TargetResult) {
+		if result.Target.Platform == "windows" {
+			result.Status = TargetFailed
+			result.Err = errors.New("compilation failed")
+			return
+		}
+		result.Status = TargetSucceeded
+	}
+
+	// With a single job, the targets are built in order so the failure cancels the rest
+	results := buildConcurrently(targets, 1, true, build)
+	want := []TargetStatus{TargetFailed, TargetCancelled, TargetCancelled}
+	for index, result := range results {
+		if result.Status != want[index] {
+			t.Errorf("buildConcurrently() with failfast %s status = %s, want %s", result.Target, result.Status, want[index])
+		}
+	}
+	if err := targetsError(results); err == nil || err.Error() != "3 of 3 targets failed to build" {
+		t.Errorf("targetsError() = %v, want 3 of 3 targets failed to build", err)
+	}
+	if platformSucceeded(results, "linux") {
+		t.Errorf("platformSucceeded() = true for cancelled targets")
+	}
+
+	// Without failfast, a failing target does not stop the others
+	results = buildConcurrently(targets, 1, false, build)
+	want = []TargetStatus{TargetFailed, TargetSucceeded, TargetSucceeded}
+	for index, result := range results {
+		if result.Status != want[index] {
+			t.Errorf("buildConcurrently() %s status = %s, want %s", result.Target, result.Status, want[index])
+		}
+	}
+	if err := targetsError(results); err == nil || err.Error() != "1 of 3 targets failed to build" {
+		t.Errorf("targetsError() = %v, want 1 of 3 targets failed to build", err)
+	}
+	if !platformSucceeded(results, "linux") || platformSucceeded(results, "windows") {
+		t.Errorf("platformSucceeded() should only be true for linux")
+	}
+}
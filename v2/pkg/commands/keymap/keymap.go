@@ -0,0 +1,121 @@
+package keymap
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+
+	"github.com/samber/lo"
+	"github.com/wailsapp/wails/v2/internal/shell"
+	"github.com/wailsapp/wails/v2/pkg/commands/buildtags"
+	"github.com/wailsapp/wails/v2/pkg/menu"
+)
+
+// envKeymapFile is set to the file the application writes its keymap to instead of generating the bindings
+const envKeymapFile = "wailskeymap"
+
+// Section is the keymap of one of the menus of the application
+type Section struct {
+	// Menu is the name of the menu, EG: "Application Menu" or "Context Menu 'files'"
+	Menu string
+	// Entries are the menu items with an accelerator
+	Entries []*menu.KeymapEntry
+	// Conflicts describe the accelerators used by more than one menu item
+	Conflicts []string
+}
+
+// Options for generating the keymap
+type Options struct {
+	Tags             []string
+	ProjectDirectory string
+}
+
+// NewSections returns the keymap of the application menu and the context menus
+func NewSections(applicationMenu *menu.Menu, contextMenus []*menu.ContextMenu) []*Section {
+	var result []*Section
+	if applicationMenu != nil {
+		result = append(result, newSection("Application Menu", applicationMenu))
+	}
+	for _, contextMenu := range contextMenus {
+		if contextMenu == nil {
+			continue
+		}
+		result = append(result, newSection(fmt.Sprintf("Context Menu '%s'", contextMenu.ID), contextMenu.Menu))
+	}
+	return result
+}
+
+func newSection(name string, inmenu *menu.Menu) *Section {
+	result := &Section{
+		Menu:    name,
+		Entries: menu.Keymap(inmenu),
+	}
+	for _, conflict := range menu.ValidateAccelerators(inmenu) {
+		result.Conflicts = append(result.Conflicts, conflict.String())
+	}
+	return result
+}
+
+// Requested returns the file to write the keymap to if the application has been run by Generate
+func Requested() string {
+	return os.Getenv(envKeymapFile)
+}
+
+// WriteFile writes the keymap to the given file
+func WriteFile(filename string, sections []*Section) error {
+	data, err := json.Marshal(sections)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filename, data, 0o644)
+}
+
+// Generate returns the keymap of the menus given in the application options of the Wails project
+// in the given ProjectDirectory. If no project directory is given then the current working directory is used.
+func Generate(options Options) ([]*Section, error) {
+
+	filename := "wailskeymap"
+	if runtime.GOOS == "windows" {
+		filename += ".exe"
+	}
+
+	// The keymap is written by the application when built for generating the bindings
+	tempDir, err := os.MkdirTemp("", "wailskeymap")
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		// Best effort removal of temp files
+		_ = os.RemoveAll(tempDir)
+	}()
+	filename = filepath.Join(tempDir, filename)
+	keymapFile := filepath.Join(tempDir, "keymap.json")
+
+	workingDirectory, _ := lo.Coalesce(options.ProjectDirectory, lo.Must(os.Getwd()))
+
+	tags := append(options.Tags, "bindings")
+	tags = lo.Without(tags, "desktop", "production", "debug", "dev")
+
+	stdout, stderr, err := shell.RunCommand(workingDirectory, "go", "build", "-tags", buildtags.Stringify(tags), "-o", filename)
+	if err != nil {
+		return nil, fmt.Errorf("%s\n%s\n%s", stdout, stderr, err)
+	}
+
+	env := shell.SetEnv(os.Environ(), envKeymapFile, keymapFile)
+	stdout, stderr, err = shell.RunCommandWithEnv(env, workingDirectory, filename)
+	if err != nil {
+		return nil, fmt.Errorf("%s\n%s\n%s", stdout, stderr, err)
+	}
+
+	data, err := os.ReadFile(keymapFile)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read the keymap of the application: %w", err)
+	}
+	var result []*Section
+	if err := json.Unmarshal(data, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
@@ -0,0 +1,102 @@
+package sbom
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"io"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type cycloneDXDocument struct {
+	BOMFormat    string               `json:"bomFormat"`
+	SpecVersion  string               `json:"specVersion"`
+	SerialNumber string               `json:"serialNumber"`
+	Version      int                  `json:"version"`
+	Metadata     cycloneDXMetadata    `json:"metadata"`
+	Components   []cycloneDXComponent `json:"components"`
+}
+
+type cycloneDXMetadata struct {
+	Timestamp string             `json:"timestamp"`
+	Tools     []cycloneDXTool    `json:"tools"`
+	Component cycloneDXComponent `json:"component"`
+}
+
+type cycloneDXTool struct {
+	Vendor  string `json:"vendor"`
+	Name    string `json:"name"`
+	Version string `json:"version,omitempty"`
+}
+
+type cycloneDXComponent struct {
+	Type     string             `json:"type"`
+	BOMRef   string             `json:"bom-ref,omitempty"`
+	Name     string             `json:"name"`
+	Version  string             `json:"version,omitempty"`
+	PURL     string             `json:"purl,omitempty"`
+	Licenses []cycloneDXLicense `json:"licenses,omitempty"`
+	Hashes   []cycloneDXHash    `json:"hashes,omitempty"`
+}
+
+type cycloneDXLicense struct {
+	Expression string `json:"expression"`
+}
+
+type cycloneDXHash struct {
+	Algorithm string `json:"alg"`
+	Content   string `json:"content"`
+}
+
+func (r *Report) writeCycloneDX(w io.Writer) error {
+	document := cycloneDXDocument{
+		BOMFormat:   "CycloneDX",
+		SpecVersion: "1.4",
+		Version:     1,
+		Metadata: cycloneDXMetadata{
+			Timestamp: r.Timestamp.Format(time.RFC3339),
+			Tools:     []cycloneDXTool{{Vendor: "Wails", Name: "wails", Version: r.WailsVersion}},
+			Component: cycloneDXComponent{
+				Type:    "application",
+				Name:    r.AppName,
+				Version: r.AppVersion,
+			},
+		},
+		Components: []cycloneDXComponent{},
+	}
+
+	for _, component := range r.Components {
+		result := cycloneDXComponent{
+			Type:    "library",
+			BOMRef:  component.PURL(),
+			Name:    component.Name,
+			Version: component.Version,
+			PURL:    component.PURL(),
+		}
+		if component.License != "" {
+			result.Licenses = []cycloneDXLicense{{Expression: component.License}}
+		}
+		if component.SHA512 != "" {
+			result.Hashes = []cycloneDXHash{{Algorithm: "SHA-512", Content: component.SHA512}}
+		}
+		document.Components = append(document.Components, result)
+	}
+
+	document.SerialNumber = "urn:uuid:" + r.documentID().String()
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(document)
+}
+
+// documentID returns an identifier derived from the contents of the report, so the
+// same dependencies always produce the same document
+func (r *Report) documentID() uuid.UUID {
+	hash := sha256.New()
+	_, _ = io.WriteString(hash, r.AppName+"@"+r.AppVersion+"\n"+r.Timestamp.Format(time.RFC3339)+"\n")
+	for _, component := range r.Components {
+		_, _ = io.WriteString(hash, component.PURL()+" "+component.License+"\n")
+	}
+	return uuid.NewSHA1(uuid.NameSpaceURL, hash.Sum(nil))
+}
@@ -0,0 +1,120 @@
+package sbom
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/wailsapp/wails/v2/internal/shell"
+	"golang.org/x/mod/module"
+)
+
+// goModules returns the Go modules used by the application. The modules are read from
+// the compiled binary using `go version -m` if one is given, otherwise from go.sum.
+func goModules(options Options) ([]Component, error) {
+	var components []Component
+	var err error
+	if options.Binary != "" {
+		stdout, stderr, err := shell.RunCommand(options.ProjectDirectory, "go", "version", "-m", options.Binary)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read the modules of %s: %s", options.Binary, stderr)
+		}
+		components = parseGoVersionOutput(stdout)
+	} else {
+		components, err = parseGoSum(filepath.Join(options.ProjectDirectory, "go.sum"))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	modCache := goModCache(options.ProjectDirectory)
+	for index := range components {
+		component := &components[index]
+		component.License, component.LicenseText = moduleLicense(modCache, component.Name, component.Version)
+	}
+	return components, nil
+}
+
+// parseGoVersionOutput parses the dependencies listed by `go version -m`. Replaced
+// modules are reported using the path and version of their replacement.
+func parseGoVersionOutput(output string) []Component {
+	var result []Component
+	scanner := bufio.NewScanner(strings.NewReader(output))
+	for scanner.Scan() {
+		fields := strings.Split(strings.TrimSpace(scanner.Text()), "\t")
+		if len(fields) < 3 {
+			continue
+		}
+		switch fields[0] {
+		case "dep":
+			result = append(result, Component{Type: GoModule, Name: fields[1], Version: fields[2]})
+		case "=>":
+			if len(result) == 0 {
+				continue
+			}
+			replaced := &result[len(result)-1]
+			replaced.Name = fields[1]
+			replaced.Version = fields[2]
+		}
+	}
+	return result
+}
+
+// parseGoSum returns the modules listed in go.sum. Modules that only have an entry for
+// their go.mod file are not part of the build and are skipped.
+func parseGoSum(filename string) ([]Component, error) {
+	file, err := os.Open(filename)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	defer file.Close()
+
+	var result []Component
+	seen := map[string]bool{}
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) != 3 || strings.HasSuffix(fields[1], "/go.mod") {
+			continue
+		}
+		key := fields[0] + "@" + fields[1]
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		result = append(result, Component{Type: GoModule, Name: fields[0], Version: fields[1]})
+	}
+	return result, scanner.Err()
+}
+
+func goModCache(projectDirectory string) string {
+	if modCache := os.Getenv("GOMODCACHE"); modCache != "" {
+		return modCache
+	}
+	stdout, _, err := shell.RunCommand(projectDirectory, "go", "env", "GOMODCACHE")
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(stdout)
+}
+
+// moduleLicense detects the license of a module in the module cache
+func moduleLicense(modCache string, path string, version string) (string, string) {
+	if modCache == "" || version == "" {
+		return "", ""
+	}
+	escapedPath, err := module.EscapePath(path)
+	if err != nil {
+		return "", ""
+	}
+	escapedVersion, err := module.EscapeVersion(version)
+	if err != nil {
+		return "", ""
+	}
+	return directoryLicense(filepath.Join(modCache, escapedPath+"@"+escapedVersion))
+}
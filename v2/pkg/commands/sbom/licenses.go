@@ -0,0 +1,81 @@
+package sbom
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// licenseFilenames are the names of license files, without extension, in lower case
+var licenseFilenames = []string{"license", "licence", "copying", "unlicense"}
+
+// licenseSignatures identify a license by phrases in its text. They are checked in
+// order, so more specific licenses come before the licenses they contain the text of.
+var licenseSignatures = []struct {
+	id      string
+	phrases []string
+}{
+	{"AGPL-3.0", []string{"GNU AFFERO GENERAL PUBLIC LICENSE"}},
+	{"LGPL-3.0", []string{"GNU LESSER GENERAL PUBLIC LICENSE", "Version 3"}},
+	{"LGPL-2.1", []string{"GNU LESSER GENERAL PUBLIC LICENSE", "Version 2.1"}},
+	{"GPL-3.0", []string{"GNU GENERAL PUBLIC LICENSE", "Version 3"}},
+	{"GPL-2.0", []string{"GNU GENERAL PUBLIC LICENSE", "Version 2"}},
+	{"MPL-2.0", []string{"Mozilla Public License", "2.0"}},
+	{"Apache-2.0", []string{"Apache License", "Version 2.0"}},
+	{"BSD-3-Clause", []string{"Redistribution and use in source and binary forms", "Neither the name"}},
+	{"BSD-2-Clause", []string{"Redistribution and use in source and binary forms"}},
+	{"ISC", []string{"Permission to use, copy, modify, and/or distribute this software for any purpose"}},
+	{"MIT", []string{"Permission is hereby granted, free of charge"}},
+	{"Unlicense", []string{"This is free and unencumbered software released into the public domain"}},
+}
+
+// detectLicense returns the SPDX identifier of the given license text, or an empty
+// string if it isn't recognised
+func detectLicense(text string) string {
+	normalised := strings.Join(strings.Fields(text), " ")
+	for _, signature := range licenseSignatures {
+		matched := true
+		for _, phrase := range signature.phrases {
+			if !strings.Contains(normalised, phrase) {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			return signature.id
+		}
+	}
+	return ""
+}
+
+// directoryLicense returns the SPDX identifier and text of the license file in the
+// given directory
+func directoryLicense(dir string) (string, string) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return "", ""
+	}
+	for _, entry := range entries {
+		if entry.IsDir() || !isLicenseFile(entry.Name()) {
+			continue
+		}
+		data, err := os.ReadFile(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			continue
+		}
+		text := string(data)
+		return detectLicense(text), text
+	}
+	return "", ""
+}
+
+func isLicenseFile(filename string) bool {
+	name := strings.ToLower(filename)
+	name = strings.TrimSuffix(name, filepath.Ext(name))
+	for _, licenseFilename := range licenseFilenames {
+		if name == licenseFilename || strings.HasPrefix(name, licenseFilename+"-") {
+			return true
+		}
+	}
+	return false
+}
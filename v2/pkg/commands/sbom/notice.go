@@ -0,0 +1,45 @@
+package sbom
+
+import (
+	"bufio"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// WriteNotice writes the third-party license notice listing every component of the
+// report along with the text of its license
+func (r *Report) WriteNotice(w io.Writer) error {
+	writer := bufio.NewWriter(w)
+	separator := strings.Repeat("=", 80)
+
+	_, _ = writer.WriteString(r.AppName + " uses the following third-party software.\n")
+	for _, component := range r.Components {
+		license := component.License
+		if license == "" {
+			license = "Unknown"
+		}
+		_, _ = writer.WriteString("\n" + separator + "\n")
+		_, _ = writer.WriteString(component.Name + " " + component.Version + "\n")
+		_, _ = writer.WriteString("License: " + license + "\n")
+		if component.LicenseText != "" {
+			_, _ = writer.WriteString(separator + "\n\n")
+			_, _ = writer.WriteString(strings.TrimSpace(component.LicenseText) + "\n")
+		}
+	}
+	return writer.Flush()
+}
+
+// WriteNoticeFile writes the third-party license notice to the given file
+func (r *Report) WriteNoticeFile(filename string) error {
+	if err := os.MkdirAll(filepath.Dir(filename), 0755); err != nil {
+		return err
+	}
+	file, err := os.Create(filename)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	return r.WriteNotice(file)
+}
@@ -0,0 +1,290 @@
+package sbom
+
+import (
+	"bufio"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/wailsapp/wails/v2/internal/fs"
+)
+
+// npmPackages returns the production dependencies of the frontend. Development
+// dependencies are not shipped with the application and are left out.
+func npmPackages(frontendDir string) ([]Component, []string, error) {
+	var components []Component
+	var err error
+
+	packageLock := filepath.Join(frontendDir, "package-lock.json")
+	yarnLock := filepath.Join(frontendDir, "yarn.lock")
+	switch {
+	case fs.FileExists(packageLock):
+		components, err = parsePackageLock(packageLock)
+	case fs.FileExists(yarnLock):
+		components, err = parseYarnLock(yarnLock, filepath.Join(frontendDir, "package.json"))
+	default:
+		var warnings []string
+		if fs.FileExists(filepath.Join(frontendDir, "package.json")) {
+			warnings = append(warnings, "No package-lock.json or yarn.lock found in "+frontendDir+": frontend dependencies are not included")
+		}
+		return nil, warnings, nil
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for index := range components {
+		component := &components[index]
+		packageDir := filepath.Join(frontendDir, "node_modules", filepath.FromSlash(component.Name))
+		license, text := directoryLicense(packageDir)
+		if component.License == "" {
+			component.License = packageJSONLicense(filepath.Join(packageDir, "package.json"))
+		}
+		if component.License == "" {
+			component.License = license
+		}
+		component.LicenseText = text
+	}
+	return components, nil, nil
+}
+
+type packageLockFile struct {
+	LockfileVersion int `json:"lockfileVersion"`
+	// Lockfile version 2 and 3
+	Packages map[string]struct {
+		Version   string          `json:"version"`
+		Integrity string          `json:"integrity"`
+		License   json.RawMessage `json:"license"`
+		Dev       bool            `json:"dev"`
+		Link      bool            `json:"link"`
+	} `json:"packages"`
+	// Lockfile version 1
+	Dependencies map[string]packageLockDependency `json:"dependencies"`
+}
+
+type packageLockDependency struct {
+	Version      string                           `json:"version"`
+	Integrity    string                           `json:"integrity"`
+	Dev          bool                             `json:"dev"`
+	Dependencies map[string]packageLockDependency `json:"dependencies"`
+}
+
+func parsePackageLock(filename string) ([]Component, error) {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	var lockFile packageLockFile
+	if err := json.Unmarshal(data, &lockFile); err != nil {
+		return nil, fmt.Errorf("unable to parse %s: %w", filename, err)
+	}
+
+	var result []Component
+	seen := map[string]bool{}
+	add := func(component Component) {
+		key := component.Name + "@" + component.Version
+		if !seen[key] {
+			seen[key] = true
+			result = append(result, component)
+		}
+	}
+
+	if lockFile.Packages != nil {
+		for path, pkg := range lockFile.Packages {
+			// The empty path is the frontend project itself
+			if path == "" || pkg.Dev || pkg.Link || pkg.Version == "" {
+				continue
+			}
+			index := strings.LastIndex(path, "node_modules/")
+			if index == -1 {
+				continue
+			}
+			add(Component{
+				Type:    NpmPackage,
+				Name:    path[index+len("node_modules/"):],
+				Version: pkg.Version,
+				License: rawLicense(pkg.License),
+				SHA512:  integritySHA512(pkg.Integrity),
+			})
+		}
+		return result, nil
+	}
+
+	var walk func(dependencies map[string]packageLockDependency)
+	walk = func(dependencies map[string]packageLockDependency) {
+		for name, dependency := range dependencies {
+			if dependency.Dev {
+				continue
+			}
+			add(Component{
+				Type:    NpmPackage,
+				Name:    name,
+				Version: dependency.Version,
+				SHA512:  integritySHA512(dependency.Integrity),
+			})
+			walk(dependency.Dependencies)
+		}
+	}
+	walk(lockFile.Dependencies)
+	return result, nil
+}
+
+// yarnEntry is a resolved package in a yarn v1 lockfile
+type yarnEntry struct {
+	name         string
+	version      string
+	integrity    string
+	dependencies []string // Dependency specifiers, EG: "lodash@^4.17.0"
+}
+
+// parseYarnLock parses a yarn v1 lockfile. Yarn doesn't record which packages are
+// development dependencies, so the packages are found by following the dependencies
+// listed in package.json.
+func parseYarnLock(filename string, packageJSON string) ([]Component, error) {
+	file, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	entries := map[string]*yarnEntry{}
+	var current *yarnEntry
+	inDependencies := false
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := scanner.Text()
+		trimmed := strings.TrimSpace(line)
+		if trimmed == "" || strings.HasPrefix(trimmed, "#") {
+			continue
+		}
+		indent := len(line) - len(strings.TrimLeft(line, " "))
+		switch {
+		case indent == 0:
+			current = &yarnEntry{}
+			inDependencies = false
+			for _, specifier := range strings.Split(strings.TrimSuffix(trimmed, ":"), ",") {
+				specifier = strings.Trim(strings.TrimSpace(specifier), `"`)
+				if current.name == "" {
+					current.name = yarnPackageName(specifier)
+				}
+				entries[specifier] = current
+			}
+		case current == nil:
+			continue
+		case indent == 2:
+			key, value, _ := strings.Cut(trimmed, " ")
+			value = strings.Trim(value, `"`)
+			inDependencies = key == "dependencies:" || key == "optionalDependencies:"
+			switch key {
+			case "version":
+				current.version = value
+			case "integrity":
+				current.integrity = value
+			}
+		case indent == 4 && inDependencies:
+			name, version, _ := strings.Cut(trimmed, " ")
+			current.dependencies = append(current.dependencies, strings.Trim(name, `"`)+"@"+strings.Trim(version, `"`))
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	data, err := os.ReadFile(packageJSON)
+	if err != nil {
+		return nil, err
+	}
+	var project struct {
+		Dependencies map[string]string `json:"dependencies"`
+	}
+	if err := json.Unmarshal(data, &project); err != nil {
+		return nil, fmt.Errorf("unable to parse %s: %w", packageJSON, err)
+	}
+
+	var queue []string
+	for name, version := range project.Dependencies {
+		queue = append(queue, name+"@"+version)
+	}
+	var result []Component
+	visited := map[*yarnEntry]bool{}
+	for len(queue) > 0 {
+		specifier := queue[0]
+		queue = queue[1:]
+		entry := entries[specifier]
+		if entry == nil || visited[entry] {
+			continue
+		}
+		visited[entry] = true
+		result = append(result, Component{
+			Type:    NpmPackage,
+			Name:    entry.name,
+			Version: entry.version,
+			SHA512:  integritySHA512(entry.integrity),
+		})
+		queue = append(queue, entry.dependencies...)
+	}
+	return result, nil
+}
+
+// yarnPackageName returns the package name of a specifier such as "@scope/name@^1.0.0"
+func yarnPackageName(specifier string) string {
+	index := strings.LastIndex(specifier, "@")
+	if index <= 0 {
+		return specifier
+	}
+	return specifier[:index]
+}
+
+// packageJSONLicense returns the license declared in a package.json file
+func packageJSONLicense(filename string) string {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return ""
+	}
+	var pkg struct {
+		License json.RawMessage `json:"license"`
+	}
+	if err := json.Unmarshal(data, &pkg); err != nil {
+		return ""
+	}
+	return rawLicense(pkg.License)
+}
+
+// rawLicense reads a license field, which is either an SPDX expression or
+// an object with a "type" field
+func rawLicense(raw json.RawMessage) string {
+	if len(raw) == 0 {
+		return ""
+	}
+	var expression string
+	if err := json.Unmarshal(raw, &expression); err == nil {
+		return expression
+	}
+	var object struct {
+		Type string `json:"type"`
+	}
+	if err := json.Unmarshal(raw, &object); err == nil {
+		return object.Type
+	}
+	return ""
+}
+
+// integritySHA512 returns the hex encoded hash of a subresource integrity value,
+// if it uses SHA-512
+func integritySHA512(integrity string) string {
+	for _, value := range strings.Fields(integrity) {
+		if !strings.HasPrefix(value, "sha512-") {
+			continue
+		}
+		hash, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(value, "sha512-"))
+		if err != nil {
+			return ""
+		}
+		return hex.EncodeToString(hash)
+	}
+	return ""
+}
@@ -0,0 +1,216 @@
+package sbom
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/wailsapp/wails/v2/internal/fs"
+)
+
+// Format is the file format of a software bill of materials
+type Format string
+
+const (
+	// CycloneDX is the CycloneDX 1.4 JSON format
+	CycloneDX Format = "cyclonedx"
+	// SPDX is the SPDX 2.3 JSON format
+	SPDX Format = "spdx"
+)
+
+// NoticeFilename is the name of the third-party license notice file
+const NoticeFilename = "THIRD_PARTY_LICENSES.txt"
+
+// ParseFormat returns the format with the given name. An empty name returns CycloneDX.
+func ParseFormat(name string) (Format, error) {
+	switch Format(strings.ToLower(name)) {
+	case "", CycloneDX:
+		return CycloneDX, nil
+	case SPDX:
+		return SPDX, nil
+	}
+	return "", fmt.Errorf("unknown SBOM format '%s'. Valid formats: cyclonedx, spdx", name)
+}
+
+// Extension returns the conventional file extension for the format
+func (f Format) Extension() string {
+	if f == SPDX {
+		return ".spdx.json"
+	}
+	return ".cdx.json"
+}
+
+// Options for generating a bill of materials
+type Options struct {
+	AppName           string    // The name of the application
+	AppVersion        string    // The version of the application
+	ProjectDirectory  string    // The directory containing go.mod and go.sum
+	FrontendDirectory string    // The directory containing the frontend lockfile
+	Binary            string    // Optional compiled application. If given, the Go modules are read from it instead of go.sum
+	Timestamp         time.Time // The creation time recorded in the bill of materials. Defaults to now
+	WailsVersion      string    // The version of Wails generating the bill of materials
+}
+
+// ComponentType indicates the ecosystem a component belongs to
+type ComponentType string
+
+const (
+	// GoModule is a Go module
+	GoModule ComponentType = "golang"
+	// NpmPackage is a package of the frontend
+	NpmPackage ComponentType = "npm"
+)
+
+// Component is a single third-party dependency
+type Component struct {
+	Type        ComponentType
+	Name        string
+	Version     string
+	License     string // SPDX license identifier or expression. Empty if unknown
+	LicenseText string // The text of the license file shipped with the dependency
+	SHA512      string // Hex encoded SHA-512 of the package archive, if known
+}
+
+// PURL returns the package URL of the component
+func (c Component) PURL() string {
+	name := c.Name
+	if c.Type == NpmPackage {
+		name = strings.Replace(name, "@", "%40", 1)
+	}
+	return "pkg:" + string(c.Type) + "/" + name + "@" + c.Version
+}
+
+// Report is the bill of materials of an application
+type Report struct {
+	AppName      string
+	AppVersion   string
+	Timestamp    time.Time
+	WailsVersion string
+	Components   []Component
+	Warnings     []string // Problems that did not prevent the report being generated
+}
+
+// Generate creates the bill of materials for the Go modules and frontend packages of a project
+func Generate(options Options) (*Report, error) {
+	report := &Report{
+		AppName:      options.AppName,
+		AppVersion:   options.AppVersion,
+		Timestamp:    options.Timestamp,
+		WailsVersion: options.WailsVersion,
+	}
+	if report.Timestamp.IsZero() {
+		report.Timestamp = time.Now()
+	}
+	report.Timestamp = report.Timestamp.UTC()
+
+	goComponents, err := goModules(options)
+	if err != nil {
+		return nil, err
+	}
+	report.Components = append(report.Components, goComponents...)
+
+	if options.FrontendDirectory != "" && fs.DirExists(options.FrontendDirectory) {
+		npmComponents, warnings, err := npmPackages(options.FrontendDirectory)
+		if err != nil {
+			return nil, err
+		}
+		report.Components = append(report.Components, npmComponents...)
+		report.Warnings = append(report.Warnings, warnings...)
+	}
+
+	sort.Slice(report.Components, func(i, j int) bool {
+		a, b := report.Components[i], report.Components[j]
+		if a.Type != b.Type {
+			return a.Type < b.Type
+		}
+		if a.Name != b.Name {
+			return a.Name < b.Name
+		}
+		return a.Version < b.Version
+	})
+
+	return report, nil
+}
+
+// Write writes the report in the given format
+func (r *Report) Write(w io.Writer, format Format) error {
+	switch format {
+	case SPDX:
+		return r.writeSPDX(w)
+	default:
+		return r.writeCycloneDX(w)
+	}
+}
+
+// WriteFile writes the report in the given format to the given file
+func (r *Report) WriteFile(filename string, format Format) error {
+	if err := os.MkdirAll(filepath.Dir(filename), 0755); err != nil {
+		return err
+	}
+	file, err := os.Create(filename)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	return r.Write(file, format)
+}
+
+// Disallowed returns the components whose license is in the given list of SPDX identifiers.
+// For license expressions, a component using "A OR B" is only disallowed if both A and B
+// are, whereas "A AND B" is disallowed if either is.
+func (r *Report) Disallowed(licenses []string) []Component {
+	if len(licenses) == 0 {
+		return nil
+	}
+	disallowed := map[string]bool{}
+	for _, license := range licenses {
+		disallowed[strings.ToLower(strings.TrimSpace(license))] = true
+	}
+
+	var result []Component
+	for _, component := range r.Components {
+		if isDisallowed(component.License, disallowed) {
+			result = append(result, component)
+		}
+	}
+	return result
+}
+
+func isDisallowed(expression string, disallowed map[string]bool) bool {
+	expression = strings.NewReplacer("(", " ", ")", " ").Replace(expression)
+	if strings.TrimSpace(expression) == "" {
+		return false
+	}
+	for _, alternative := range strings.Split(expression, " OR ") {
+		allowed := true
+		for _, license := range strings.Split(alternative, " AND ") {
+			if disallowed[strings.ToLower(strings.TrimSpace(license))] {
+				allowed = false
+				break
+			}
+		}
+		if allowed {
+			return false
+		}
+	}
+	return true
+}
+
+// CheckLicenses returns an error listing the components using one of the given licenses
+func (r *Report) CheckLicenses(disallowed []string) error {
+	components := r.Disallowed(disallowed)
+	if len(components) == 0 {
+		return nil
+	}
+	var message strings.Builder
+	message.WriteString("the following dependencies use a disallowed license:")
+	for _, component := range components {
+		message.WriteString(fmt.Sprintf("\n  %s %s (%s)", component.Name, component.Version, component.License))
+	}
+	return errors.New(message.String())
+}
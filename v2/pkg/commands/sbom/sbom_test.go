@@ -0,0 +1,166 @@
+package sbom
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/matryer/is"
+)
+
+func writeTestFile(t *testing.T, filename string, content string) {
+	t.Helper()
+	if err := os.MkdirAll(filepath.Dir(filename), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filename, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestParseGoVersionOutput(t *testing.T) {
+	is2 := is.New(t)
+
+	output := "app: go1.20\n" +
+		"\tpath\tchangeme\n" +
+		"\tmod\tchangeme\t(devel)\t\n" +
+		"\tdep\tgithub.com/samber/lo\tv1.38.1\th1:abc=\n" +
+		"\tdep\tgithub.com/wailsapp/wails/v2\tv2.6.0\n" +
+		"\t=>\tgithub.com/fork/wails/v2\tv2.6.1\th1:def=\n" +
+		"\tbuild\t-compiler=gc\n"
+
+	is2.Equal(parseGoVersionOutput(output), []Component{
+		{Type: GoModule, Name: "github.com/samber/lo", Version: "v1.38.1"},
+		{Type: GoModule, Name: "github.com/fork/wails/v2", Version: "v2.6.1"},
+	})
+}
+
+func TestParseGoSum(t *testing.T) {
+	is2 := is.New(t)
+
+	goSum := filepath.Join(t.TempDir(), "go.sum")
+	writeTestFile(t, goSum, "github.com/samber/lo v1.38.1 h1:abc=\n"+
+		"github.com/samber/lo v1.38.1/go.mod h1:def=\n"+
+		"golang.org/x/exp v0.0.0-20220303212507-bbda1eaf7a17/go.mod h1:ghi=\n")
+
+	components, err := parseGoSum(goSum)
+	is2.NoErr(err)
+	is2.Equal(components, []Component{{Type: GoModule, Name: "github.com/samber/lo", Version: "v1.38.1"}})
+}
+
+func TestParsePackageLock(t *testing.T) {
+	is2 := is.New(t)
+
+	packageLock := filepath.Join(t.TempDir(), "package-lock.json")
+	writeTestFile(t, packageLock, `{
+  "lockfileVersion": 3,
+  "packages": {
+    "": {"name": "frontend"},
+    "node_modules/vite": {"version": "3.0.7", "dev": true, "license": "MIT"},
+    "node_modules/@scope/lib": {"version": "1.0.0", "license": "Apache-2.0"},
+    "node_modules/@scope/lib/node_modules/dep": {"version": "2.0.0", "license": {"type": "ISC"}}
+  }
+}`)
+
+	components, err := parsePackageLock(packageLock)
+	is2.NoErr(err)
+	is2.Equal(len(components), 2)
+	licenses := map[string]string{}
+	for _, component := range components {
+		licenses[component.Name+"@"+component.Version] = component.License
+	}
+	is2.Equal(licenses, map[string]string{"@scope/lib@1.0.0": "Apache-2.0", "dep@2.0.0": "ISC"})
+}
+
+func TestParseYarnLock(t *testing.T) {
+	is2 := is.New(t)
+
+	dir := t.TempDir()
+	writeTestFile(t, filepath.Join(dir, "package.json"), `{"dependencies": {"@scope/lib": "^1.0.0"}, "devDependencies": {"vite": "^3.0.0"}}`)
+	writeTestFile(t, filepath.Join(dir, "yarn.lock"), `# yarn lockfile v1
+
+
+"@scope/lib@^1.0.0", "@scope/lib@^1.0.1":
+  version "1.0.2"
+  resolved "https://registry.yarnpkg.com/@scope/lib/-/lib-1.0.2.tgz"
+  dependencies:
+    dep "~2.0.0"
+
+dep@~2.0.0:
+  version "2.0.1"
+
+vite@^3.0.0:
+  version "3.0.7"
+`)
+
+	components, err := parseYarnLock(filepath.Join(dir, "yarn.lock"), filepath.Join(dir, "package.json"))
+	is2.NoErr(err)
+	is2.Equal(components, []Component{
+		{Type: NpmPackage, Name: "@scope/lib", Version: "1.0.2"},
+		{Type: NpmPackage, Name: "dep", Version: "2.0.1"},
+	})
+}
+
+func TestDetectLicense(t *testing.T) {
+	is2 := is.New(t)
+
+	is2.Equal(detectLicense("MIT License\n\nPermission is hereby granted, free of charge, to any person"), "MIT")
+	is2.Equal(detectLicense("Apache License\n   Version 2.0, January 2004"), "Apache-2.0")
+	is2.Equal(detectLicense("GNU LESSER GENERAL PUBLIC LICENSE\nVersion 3, 29 June 2007"), "LGPL-3.0")
+	is2.Equal(detectLicense("Redistribution and use in source and\nbinary forms ... Neither the name of"), "BSD-3-Clause")
+	is2.Equal(detectLicense("All rights reserved"), "")
+}
+
+func TestReport_Disallowed(t *testing.T) {
+	is2 := is.New(t)
+
+	report := &Report{Components: []Component{
+		{Name: "gpl", License: "GPL-3.0"},
+		{Name: "dual", License: "(GPL-3.0 OR MIT)"},
+		{Name: "both", License: "MIT AND GPL-3.0"},
+		{Name: "unknown"},
+	}}
+
+	var names []string
+	for _, component := range report.Disallowed([]string{"gpl-3.0"}) {
+		names = append(names, component.Name)
+	}
+	is2.Equal(names, []string{"gpl", "both"})
+	is2.NoErr(report.CheckLicenses(nil))
+	is2.True(report.CheckLicenses([]string{"GPL-3.0"}) != nil)
+}
+
+func TestReport_Write(t *testing.T) {
+	is2 := is.New(t)
+
+	report := &Report{
+		AppName:    "app",
+		AppVersion: "1.0.0",
+		Timestamp:  time.Unix(0, 0).UTC(),
+		Components: []Component{
+			{Type: GoModule, Name: "github.com/samber/lo", Version: "v1.38.1", License: "MIT"},
+			{Type: NpmPackage, Name: "@scope/lib", Version: "1.0.0"},
+		},
+	}
+
+	var first, second bytes.Buffer
+	is2.NoErr(report.Write(&first, CycloneDX))
+	is2.NoErr(report.Write(&second, CycloneDX))
+	is2.Equal(first.String(), second.String())
+
+	var cycloneDX cycloneDXDocument
+	is2.NoErr(json.Unmarshal(first.Bytes(), &cycloneDX))
+	is2.Equal(len(cycloneDX.Components), 2)
+	is2.Equal(cycloneDX.Components[1].PURL, "pkg:npm/%40scope/lib@1.0.0")
+
+	var buffer bytes.Buffer
+	is2.NoErr(report.Write(&buffer, SPDX))
+	var spdx spdxDocument
+	is2.NoErr(json.Unmarshal(buffer.Bytes(), &spdx))
+	is2.Equal(len(spdx.Packages), 3)
+	is2.Equal(spdx.Packages[1].LicenseDeclared, "MIT")
+	is2.Equal(spdx.Packages[2].LicenseDeclared, "NOASSERTION")
+}
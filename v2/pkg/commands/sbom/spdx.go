@@ -0,0 +1,122 @@
+package sbom
+
+import (
+	"encoding/json"
+	"io"
+	"strconv"
+	"time"
+)
+
+type spdxDocument struct {
+	SPDXVersion       string             `json:"spdxVersion"`
+	DataLicense       string             `json:"dataLicense"`
+	SPDXID            string             `json:"SPDXID"`
+	Name              string             `json:"name"`
+	DocumentNamespace string             `json:"documentNamespace"`
+	CreationInfo      spdxCreationInfo   `json:"creationInfo"`
+	Packages          []spdxPackage      `json:"packages"`
+	Relationships     []spdxRelationship `json:"relationships"`
+}
+
+type spdxCreationInfo struct {
+	Created  string   `json:"created"`
+	Creators []string `json:"creators"`
+}
+
+type spdxPackage struct {
+	Name             string            `json:"name"`
+	SPDXID           string            `json:"SPDXID"`
+	VersionInfo      string            `json:"versionInfo,omitempty"`
+	DownloadLocation string            `json:"downloadLocation"`
+	FilesAnalyzed    bool              `json:"filesAnalyzed"`
+	LicenseConcluded string            `json:"licenseConcluded"`
+	LicenseDeclared  string            `json:"licenseDeclared"`
+	CopyrightText    string            `json:"copyrightText"`
+	Checksums        []spdxChecksum    `json:"checksums,omitempty"`
+	ExternalRefs     []spdxExternalRef `json:"externalRefs,omitempty"`
+}
+
+type spdxChecksum struct {
+	Algorithm string `json:"algorithm"`
+	Value     string `json:"checksumValue"`
+}
+
+type spdxExternalRef struct {
+	Category string `json:"referenceCategory"`
+	Type     string `json:"referenceType"`
+	Locator  string `json:"referenceLocator"`
+}
+
+type spdxRelationship struct {
+	Element        string `json:"spdxElementId"`
+	Type           string `json:"relationshipType"`
+	RelatedElement string `json:"relatedSpdxElement"`
+}
+
+const (
+	spdxNoAssertion   = "NOASSERTION"
+	spdxApplicationID = "SPDXRef-Application"
+)
+
+func (r *Report) writeSPDX(w io.Writer) error {
+	document := spdxDocument{
+		SPDXVersion:       "SPDX-2.3",
+		DataLicense:       "CC0-1.0",
+		SPDXID:            "SPDXRef-DOCUMENT",
+		Name:              r.AppName,
+		DocumentNamespace: "https://wails.io/spdxdocs/" + r.AppName + "-" + r.documentID().String(),
+		CreationInfo: spdxCreationInfo{
+			Created:  r.Timestamp.Format(time.RFC3339),
+			Creators: []string{"Tool: wails-" + r.WailsVersion},
+		},
+		Packages: []spdxPackage{{
+			Name:             r.AppName,
+			SPDXID:           spdxApplicationID,
+			VersionInfo:      r.AppVersion,
+			DownloadLocation: spdxNoAssertion,
+			LicenseConcluded: spdxNoAssertion,
+			LicenseDeclared:  spdxNoAssertion,
+			CopyrightText:    spdxNoAssertion,
+		}},
+		Relationships: []spdxRelationship{{
+			Element:        "SPDXRef-DOCUMENT",
+			Type:           "DESCRIBES",
+			RelatedElement: spdxApplicationID,
+		}},
+	}
+
+	for index, component := range r.Components {
+		id := "SPDXRef-Package-" + strconv.Itoa(index+1)
+		license := component.License
+		if license == "" {
+			license = spdxNoAssertion
+		}
+		pkg := spdxPackage{
+			Name:             component.Name,
+			SPDXID:           id,
+			VersionInfo:      component.Version,
+			DownloadLocation: spdxNoAssertion,
+			LicenseConcluded: spdxNoAssertion,
+			LicenseDeclared:  license,
+			CopyrightText:    spdxNoAssertion,
+			ExternalRefs: []spdxExternalRef{{
+				Category: "PACKAGE-MANAGER",
+				Type:     "purl",
+				Locator:  component.PURL(),
+			}},
+		}
+		if component.SHA512 != "" {
+			pkg.Checksums = []spdxChecksum{{Algorithm: "SHA512", Value: component.SHA512}}
+		}
+		document.Packages = append(document.Packages, pkg)
+		document.Relationships = append(document.Relationships, spdxRelationship{
+			Element:        spdxApplicationID,
+			Type:           "DEPENDS_ON",
+			RelatedElement: id,
+		})
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(document)
+}
@@ -0,0 +1,149 @@
+// Package crashreport defines the crash reports written by the crash reporter
+// and the bundles they are stored in.
+package crashreport
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/wailsapp/wails/v2/internal/buildinfo"
+)
+
+// The files of a report bundle
+const (
+	ReportFilename     = "report.json"
+	GoroutinesFilename = "goroutines.txt"
+	LogsFilename       = "logs.txt"
+
+	// shownFilename marks a report as having been shown to the user
+	shownFilename = ".shown"
+)
+
+// Sources of crashes
+const (
+	SourceMain          = "main"
+	SourceBoundMethod   = "bound method"
+	SourceEventListener = "event listener"
+	SourceWebView       = "webview"
+)
+
+// BuildInfo holds the build information embedded in the application
+type BuildInfo = buildinfo.Info
+
+// OSInfo describes the operating system the application crashed on
+type OSInfo struct {
+	ID           string `json:"id"`
+	Name         string `json:"name"`
+	Version      string `json:"version"`
+	Architecture string `json:"architecture"`
+	GoVersion    string `json:"goVersion"`
+}
+
+// Report describes a crash of the application
+type Report struct {
+	ID      string    `json:"id"`      // Unique ID of the report. Also the name of the bundle directory
+	Time    time.Time `json:"time"`    // The time of the crash
+	AppName string    `json:"appName"` // The name of the application
+	Source  string    `json:"source"`  // Where the crash occurred. See the Source constants
+	Message string    `json:"message"` // The panic value or the reason the WebView process ended
+	Build   BuildInfo `json:"build"`
+	OS      OSInfo    `json:"os"`
+
+	Goroutines string   `json:"-"` // Stack traces of all goroutines. Stored in goroutines.txt
+	Logs       []string `json:"-"` // The most recent logs before the crash. Stored in logs.txt
+	Dir        string   `json:"-"` // The directory of the report bundle
+}
+
+// Files returns the paths of the files in the report bundle
+func (r *Report) Files() []string {
+	return []string{
+		filepath.Join(r.Dir, ReportFilename),
+		filepath.Join(r.Dir, GoroutinesFilename),
+		filepath.Join(r.Dir, LogsFilename),
+	}
+}
+
+// Write writes the report bundle to a directory named after the report ID in the given directory
+func (r *Report) Write(dir string) error {
+	r.Dir = filepath.Join(dir, r.ID)
+	if err := os.MkdirAll(r.Dir, 0755); err != nil {
+		return err
+	}
+
+	data, err := json.MarshalIndent(r, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(filepath.Join(r.Dir, GoroutinesFilename), []byte(r.Goroutines), 0644); err != nil {
+		return err
+	}
+	if err := os.WriteFile(filepath.Join(r.Dir, LogsFilename), []byte(strings.Join(r.Logs, "\n")), 0644); err != nil {
+		return err
+	}
+	// The report is written last so that incomplete bundles are ignored by Read
+	return os.WriteFile(filepath.Join(r.Dir, ReportFilename), data, 0644)
+}
+
+// Read reads the report bundle in the given directory
+func Read(bundleDir string) (*Report, error) {
+	data, err := os.ReadFile(filepath.Join(bundleDir, ReportFilename))
+	if err != nil {
+		return nil, err
+	}
+	var result Report
+	if err := json.Unmarshal(data, &result); err != nil {
+		return nil, fmt.Errorf("invalid crash report %s: %w", bundleDir, err)
+	}
+	result.Dir = bundleDir
+
+	goroutines, err := os.ReadFile(filepath.Join(bundleDir, GoroutinesFilename))
+	if err == nil {
+		result.Goroutines = string(goroutines)
+	}
+	logs, err := os.ReadFile(filepath.Join(bundleDir, LogsFilename))
+	if err == nil && len(logs) > 0 {
+		result.Logs = strings.Split(string(logs), "\n")
+	}
+	return &result, nil
+}
+
+// Pending returns the reports in the given directory that have not been shown to the user, oldest first
+func Pending(dir string) ([]*Report, error) {
+	entries, err := os.ReadDir(dir)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var result []*Report
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		bundleDir := filepath.Join(dir, entry.Name())
+		if _, err := os.Stat(filepath.Join(bundleDir, shownFilename)); err == nil {
+			continue
+		}
+		report, err := Read(bundleDir)
+		if err != nil {
+			continue
+		}
+		result = append(result, report)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Time.Before(result[j].Time)
+	})
+	return result, nil
+}
+
+// MarkShown records that the report has been shown to the user so that it is no longer pending
+func (r *Report) MarkShown() error {
+	return os.WriteFile(filepath.Join(r.Dir, shownFilename), nil, 0644)
+}
@@ -0,0 +1,55 @@
+package crashreport
+
+import (
+	"testing"
+	"time"
+
+	"github.com/matryer/is"
+)
+
+func TestReport_WriteRead(t *testing.T) {
+	is2 := is.New(t)
+
+	dir := t.TempDir()
+	report := &Report{
+		ID:         "20230102-150405-abcdef12",
+		Time:       time.Date(2023, 1, 2, 15, 4, 5, 0, time.UTC),
+		AppName:    "app",
+		Source:     SourceBoundMethod,
+		Message:    "runtime error: index out of range [3] with length 3",
+		OS:         OSInfo{ID: "ubuntu", Architecture: "amd64"},
+		Goroutines: "goroutine 1 [running]:\nmain.main()",
+		Logs:       []string{"first", "second"},
+	}
+	is2.NoErr(report.Write(dir))
+
+	read, err := Read(report.Dir)
+	is2.NoErr(err)
+	is2.Equal(read, report)
+	is2.Equal(len(read.Files()), 3)
+}
+
+func TestPending(t *testing.T) {
+	is2 := is.New(t)
+
+	dir := t.TempDir()
+	newer := &Report{ID: "newer", Time: time.Date(2023, 1, 3, 0, 0, 0, 0, time.UTC)}
+	older := &Report{ID: "older", Time: time.Date(2023, 1, 2, 0, 0, 0, 0, time.UTC)}
+	is2.NoErr(newer.Write(dir))
+	is2.NoErr(older.Write(dir))
+
+	pending, err := Pending(dir)
+	is2.NoErr(err)
+	is2.Equal(len(pending), 2)
+	is2.Equal(pending[0].ID, "older")
+
+	is2.NoErr(pending[0].MarkShown())
+	pending, err = Pending(dir)
+	is2.NoErr(err)
+	is2.Equal(len(pending), 1)
+	is2.Equal(pending[0].ID, "newer")
+
+	pending, err = Pending(dir + "/missing")
+	is2.NoErr(err)
+	is2.Equal(len(pending), 0)
+}
@@ -0,0 +1,8 @@
+//go:build !go1.21
+// +build !go1.21
+
+package logger
+
+// SlogHandler is a placeholder for the log/slog handler as log/slog requires Go 1.21.
+// Setting it in the application options has no effect.
+type SlogHandler = interface{}
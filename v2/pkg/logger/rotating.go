@@ -0,0 +1,484 @@
+package logger
+
+import (
+	"bufio"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/wailsapp/wails/v2/internal/fs"
+	"github.com/wailsapp/wails/v2/internal/signal"
+)
+
+const (
+	defaultMaxSizeMB      = 10
+	defaultMaxFiles       = 5
+	rotatedTimeFormat     = "20060102-150405"
+	rotatingBufferSize    = 64 * 1024
+	rotatingFlushInterval = time.Second
+)
+
+// RotatingOptions configures a RotatingFileLogger
+type RotatingOptions struct {
+	// Dir is the directory the log files are written to.
+	// Defaults to the per-user log directory of the application. See DefaultLogDirectory.
+	Dir string
+
+	// Filename is the name of the active log file. Defaults to "<executable name>.log"
+	Filename string
+
+	// MaxSizeMB is the size in megabytes at which the log file is rotated. Defaults to 10
+	MaxSizeMB int
+
+	// MaxFiles is the number of rotated log files to keep. Defaults to 5
+	MaxFiles int
+
+	// Compress gzip compresses rotated log files
+	Compress bool
+
+	// OnError is called when writing to or rotating the log file fails. If nil, the first
+	// error is returned by the next call to Flush or Close.
+	OnError func(err error)
+}
+
+// Flusher is implemented by loggers that buffer their output
+type Flusher interface {
+	Flush() error
+}
+
+// RotatingFileLogger logs messages to a file that is rotated when it reaches
+// a maximum size or when the day changes. Output is buffered and flushed every
+// second, immediately for Warning level logs and above, on Fatal and when
+// the application is interrupted.
+type RotatingFileLogger struct {
+	options RotatingOptions
+	maxSize int64
+
+	lock    sync.Mutex
+	file    *os.File
+	writer  *bufio.Writer
+	size    int64
+	opened  time.Time // The time the current log file was started
+	written time.Time // The time of the last write to the current log file
+	err     error     // The first error since the last Flush or Close, if there is no OnError
+	closed  bool
+	done    chan struct{}
+	flushed sync.WaitGroup
+}
+
+// NewRotatingFileLogger creates a new RotatingFileLogger. The log directory is
+// created if it does not exist.
+func NewRotatingFileLogger(options RotatingOptions) (*RotatingFileLogger, error) {
+	appName := fs.ExecutableName()
+	if options.Dir == "" {
+		dir, err := DefaultLogDirectory(appName)
+		if err != nil {
+			return nil, err
+		}
+		options.Dir = dir
+	}
+	if options.Filename == "" {
+		options.Filename = appName + ".log"
+	}
+	if options.MaxSizeMB <= 0 {
+		options.MaxSizeMB = defaultMaxSizeMB
+	}
+	if options.MaxFiles <= 0 {
+		options.MaxFiles = defaultMaxFiles
+	}
+
+	if err := os.MkdirAll(options.Dir, 0755); err != nil {
+		return nil, fmt.Errorf("unable to create log directory: %w", err)
+	}
+
+	result := &RotatingFileLogger{
+		options: options,
+		maxSize: int64(options.MaxSizeMB) * 1024 * 1024,
+		done:    make(chan struct{}),
+	}
+	if err := result.open(time.Now()); err != nil {
+		return nil, err
+	}
+
+	result.flushed.Add(1)
+	go result.flushPeriodically()
+
+	// Make sure buffered logs are written when the application is interrupted
+	signal.OnShutdown(func() {
+		_ = result.Flush()
+	})
+
+	return result, nil
+}
+
+// DefaultLogDirectory returns the per-user log directory for the given application:
+//
+//	Linux:   $XDG_STATE_HOME/<appName>/logs, or ~/.local/state/<appName>/logs
+//	macOS:   ~/Library/Logs/<appName>
+//	Windows: %LOCALAPPDATA%\<appName>\Logs
+func DefaultLogDirectory(appName string) (string, error) {
+	switch runtime.GOOS {
+	case "windows":
+		localAppData := os.Getenv("LOCALAPPDATA")
+		if localAppData == "" {
+			configDir, err := os.UserConfigDir()
+			if err != nil {
+				return "", err
+			}
+			localAppData = configDir
+		}
+		return filepath.Join(localAppData, appName, "Logs"), nil
+	case "darwin":
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return "", err
+		}
+		return filepath.Join(home, "Library", "Logs", appName), nil
+	default:
+		stateHome := os.Getenv("XDG_STATE_HOME")
+		if stateHome == "" {
+			home, err := os.UserHomeDir()
+			if err != nil {
+				return "", err
+			}
+			stateHome = filepath.Join(home, ".local", "state")
+		}
+		return filepath.Join(stateHome, appName, "logs"), nil
+	}
+}
+
+// Filename returns the path of the active log file
+func (l *RotatingFileLogger) Filename() string {
+	return filepath.Join(l.options.Dir, l.options.Filename)
+}
+
+// Print writes the message without a log level
+func (l *RotatingFileLogger) Print(message string) {
+	l.write(message, false)
+}
+
+// Trace level logging
+func (l *RotatingFileLogger) Trace(message string) {
+	l.write(l.format("TRACE", message), false)
+}
+
+// Debug level logging
+func (l *RotatingFileLogger) Debug(message string) {
+	l.write(l.format("DEBUG", message), false)
+}
+
+// Info level logging
+func (l *RotatingFileLogger) Info(message string) {
+	l.write(l.format("INFO ", message), false)
+}
+
+// Warning level logging. The log is flushed immediately.
+func (l *RotatingFileLogger) Warning(message string) {
+	l.write(l.format("WARN ", message), true)
+}
+
+// Error level logging. The log is flushed immediately.
+func (l *RotatingFileLogger) Error(message string) {
+	l.write(l.format("ERROR", message), true)
+}
+
+// Fatal level logging. The log is flushed and synced to disk before exiting.
+func (l *RotatingFileLogger) Fatal(message string) {
+	l.write(l.format("FATAL", message), true)
+	_ = l.Close()
+	os.Exit(1)
+}
+
+// Flush writes any buffered logs to the log file. It returns the first error
+// writing or rotating the log file since the last Flush, unless OnError is set.
+func (l *RotatingFileLogger) Flush() error {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	if l.closed {
+		return nil
+	}
+	err := l.takeError()
+	if flushErr := l.writer.Flush(); err == nil {
+		err = flushErr
+	}
+	return err
+}
+
+// Close flushes any buffered logs, syncs the log file to disk and closes it.
+// Logs written after Close are discarded.
+func (l *RotatingFileLogger) Close() error {
+	l.lock.Lock()
+	if l.closed {
+		l.lock.Unlock()
+		return nil
+	}
+	l.closed = true
+	err := l.takeError()
+	if closeErr := l.closeFile(); err == nil {
+		err = closeErr
+	}
+	l.lock.Unlock()
+
+	close(l.done)
+	l.flushed.Wait()
+	return err
+}
+
+func (l *RotatingFileLogger) format(level string, message string) string {
+	return time.Now().Format("2006-01-02 15:04:05.000") + " | " + level + " | " + message
+}
+
+func (l *RotatingFileLogger) write(message string, flush bool) {
+	if !strings.HasSuffix(message, "\n") {
+		message += "\n"
+	}
+
+	l.lock.Lock()
+	if l.closed {
+		l.lock.Unlock()
+		return
+	}
+
+	var errs []error
+	now := time.Now()
+	if l.size > 0 && (l.size+int64(len(message)) > l.maxSize || !sameDay(l.opened, now)) {
+		if err := l.rotate(now); err != nil {
+			errs = append(errs, fmt.Errorf("unable to rotate log file: %w", err))
+		}
+	}
+
+	n, err := l.writer.WriteString(message)
+	l.size += int64(n)
+	l.written = now
+	if err == nil && flush {
+		err = l.writer.Flush()
+	}
+	if err != nil {
+		errs = append(errs, fmt.Errorf("unable to write to log file: %w", err))
+	}
+	onError := l.options.OnError
+	if onError == nil {
+		for _, err := range errs {
+			l.keepError(err)
+		}
+	}
+	l.lock.Unlock()
+
+	// The callback is called without the lock held, so that it may log
+	if onError != nil {
+		for _, err := range errs {
+			onError(err)
+		}
+	}
+}
+
+// keepError keeps the first error for Flush or Close to return
+func (l *RotatingFileLogger) keepError(err error) {
+	if l.err == nil {
+		l.err = err
+	}
+}
+
+// takeError returns and clears the kept error
+func (l *RotatingFileLogger) takeError() error {
+	err := l.err
+	l.err = nil
+	return err
+}
+
+// open opens the active log file for appending. If the existing file was
+// started on a previous day, it is rotated first.
+func (l *RotatingFileLogger) open(now time.Time) error {
+	filename := l.Filename()
+	info, err := os.Stat(filename)
+	if err == nil && info.Size() > 0 && !sameDay(info.ModTime(), now) {
+		if err := l.archive(info.ModTime()); err != nil {
+			return err
+		}
+		info = nil
+	}
+
+	file, err := os.OpenFile(filename, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return fmt.Errorf("unable to open log file: %w", err)
+	}
+	l.file = file
+	l.writer = bufio.NewWriterSize(file, rotatingBufferSize)
+	l.size = 0
+	l.opened = now
+	l.written = now
+	if info != nil {
+		l.size = info.Size()
+		l.written = info.ModTime()
+	}
+	return nil
+}
+
+// rotate closes the active log file, archives it and starts a new one. The archive
+// is named after the time of the last write, which is on the day of its logs.
+func (l *RotatingFileLogger) rotate(now time.Time) error {
+	// Always reopen the log file so that logging continues if closing or archiving fails
+	err := l.closeFile()
+	if archiveErr := l.archive(l.written); err == nil {
+		err = archiveErr
+	}
+	if openErr := l.open(now); openErr != nil {
+		return openErr
+	}
+	return err
+}
+
+func (l *RotatingFileLogger) closeFile() error {
+	err := l.writer.Flush()
+	if syncErr := l.file.Sync(); err == nil {
+		err = syncErr
+	}
+	if closeErr := l.file.Close(); err == nil {
+		err = closeErr
+	}
+	return err
+}
+
+// archive renames the active log file to a timestamped name, compresses it if
+// required and removes the oldest archives beyond MaxFiles
+func (l *RotatingFileLogger) archive(timestamp time.Time) error {
+	base := strings.TrimSuffix(l.options.Filename, filepath.Ext(l.options.Filename))
+	ext := filepath.Ext(l.options.Filename)
+
+	archived := filepath.Join(l.options.Dir, base+"-"+timestamp.Format(rotatedTimeFormat)+ext)
+	for i := 1; fileExists(archived) || fileExists(archived+".gz"); i++ {
+		archived = filepath.Join(l.options.Dir, fmt.Sprintf("%s-%s.%d%s", base, timestamp.Format(rotatedTimeFormat), i, ext))
+	}
+	if err := os.Rename(l.Filename(), archived); err != nil {
+		return err
+	}
+
+	if l.options.Compress {
+		if err := compressFile(archived); err != nil {
+			return err
+		}
+	}
+
+	return l.prune(base, ext)
+}
+
+// prune removes the oldest archived log files so that at most MaxFiles remain
+func (l *RotatingFileLogger) prune(base string, ext string) error {
+	entries, err := os.ReadDir(l.options.Dir)
+	if err != nil {
+		return err
+	}
+	var archives []string
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		// Other files in the directory, EG: "app-errors.log", are kept
+		if _, _, ok := archiveOrder(entry.Name(), base, ext); ok {
+			archives = append(archives, entry.Name())
+		}
+	}
+	if len(archives) <= l.options.MaxFiles {
+		return nil
+	}
+	// Sort chronologically by the timestamp and then the counter in the names
+	sort.Slice(archives, func(i, j int) bool {
+		timestampI, counterI, _ := archiveOrder(archives[i], base, ext)
+		timestampJ, counterJ, _ := archiveOrder(archives[j], base, ext)
+		if timestampI != timestampJ {
+			return timestampI < timestampJ
+		}
+		return counterI < counterJ
+	})
+	for _, name := range archives[:len(archives)-l.options.MaxFiles] {
+		if err := os.Remove(filepath.Join(l.options.Dir, name)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// archiveOrder returns the timestamp and counter from the name of an archived log file,
+// EG: "app-20230102-150405.1.log.gz" returns "20230102-150405" and 1.
+// It returns false if the name is not the name of an archived log file.
+func archiveOrder(name string, base string, ext string) (string, int, bool) {
+	name = strings.TrimSuffix(name, ".gz")
+	if !strings.HasPrefix(name, base+"-") || !strings.HasSuffix(name, ext) {
+		return "", 0, false
+	}
+	name = strings.TrimSuffix(strings.TrimPrefix(name, base+"-"), ext)
+	timestamp, counter, found := strings.Cut(name, ".")
+	if _, err := time.Parse(rotatedTimeFormat, timestamp); err != nil {
+		return "", 0, false
+	}
+	if !found {
+		return timestamp, 0, true
+	}
+	number, err := strconv.Atoi(counter)
+	if err != nil || number < 1 {
+		return "", 0, false
+	}
+	return timestamp, number, true
+}
+
+func (l *RotatingFileLogger) flushPeriodically() {
+	defer l.flushed.Done()
+	ticker := time.NewTicker(rotatingFlushInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			_ = l.Flush()
+		case <-l.done:
+			return
+		}
+	}
+}
+
+// compressFile gzip compresses the given file to <filename>.gz and removes the original
+func compressFile(filename string) error {
+	source, err := os.Open(filename)
+	if err != nil {
+		return err
+	}
+	defer source.Close()
+
+	target, err := os.OpenFile(filename+".gz", os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+	writer := gzip.NewWriter(target)
+	_, err = io.Copy(writer, source)
+	if closeErr := writer.Close(); err == nil {
+		err = closeErr
+	}
+	if closeErr := target.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		_ = os.Remove(filename + ".gz")
+		return err
+	}
+
+	source.Close()
+	return os.Remove(filename)
+}
+
+func sameDay(a time.Time, b time.Time) bool {
+	ay, am, ad := a.Date()
+	by, bm, bd := b.Date()
+	return ay == by && am == bm && ad == bd
+}
+
+func fileExists(filename string) bool {
+	_, err := os.Stat(filename)
+	return err == nil
+}
@@ -0,0 +1,184 @@
+package logger
+
+import (
+	"compress/gzip"
+	"io"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/matryer/is"
+)
+
+func TestRotatingFileLogger_RotatesBySize(t *testing.T) {
+	is2 := is.New(t)
+
+	dir := t.TempDir()
+	log, err := NewRotatingFileLogger(RotatingOptions{Dir: dir, Filename: "app.log", MaxFiles: 2, Compress: true})
+	is2.NoErr(err)
+	log.maxSize = 100
+
+	for i := 0; i < 10; i++ {
+		log.Info(strings.Repeat("x", 40))
+	}
+	is2.NoErr(log.Close())
+
+	entries, err := os.ReadDir(dir)
+	is2.NoErr(err)
+	var archives []string
+	for _, entry := range entries {
+		if entry.Name() != "app.log" {
+			archives = append(archives, entry.Name())
+		}
+	}
+	is2.Equal(len(archives), 2) // Only MaxFiles archives are kept
+	for _, archive := range archives {
+		is2.True(strings.HasPrefix(archive, "app-"))
+		is2.True(strings.HasSuffix(archive, ".log.gz"))
+	}
+
+	file, err := os.Open(filepath.Join(dir, archives[0]))
+	is2.NoErr(err)
+	defer file.Close()
+	reader, err := gzip.NewReader(file)
+	is2.NoErr(err)
+	content, err := io.ReadAll(reader)
+	is2.NoErr(err)
+	is2.True(strings.Contains(string(content), "| INFO  | xxxx"))
+}
+
+func TestRotatingFileLogger_RotatesPreviousDay(t *testing.T) {
+	is2 := is.New(t)
+
+	dir := t.TempDir()
+	filename := filepath.Join(dir, "app.log")
+	is2.NoErr(os.WriteFile(filename, []byte("yesterday\n"), 0644))
+	yesterday := time.Now().AddDate(0, 0, -1)
+	is2.NoErr(os.Chtimes(filename, yesterday, yesterday))
+
+	log, err := NewRotatingFileLogger(RotatingOptions{Dir: dir, Filename: "app.log"})
+	is2.NoErr(err)
+	log.Print("today")
+	is2.NoErr(log.Flush())
+
+	content, err := os.ReadFile(filename)
+	is2.NoErr(err)
+	is2.Equal(string(content), "today\n")
+
+	archived, err := os.ReadFile(filepath.Join(dir, "app-"+yesterday.Format(rotatedTimeFormat)+".log"))
+	is2.NoErr(err)
+	is2.Equal(string(archived), "yesterday\n")
+	is2.NoErr(log.Close())
+}
+
+func TestRotatingFileLogger_ArchivesDayChangeWithLastWrite(t *testing.T) {
+	is2 := is.New(t)
+
+	dir := t.TempDir()
+	log, err := NewRotatingFileLogger(RotatingOptions{Dir: dir, Filename: "app.log"})
+	is2.NoErr(err)
+	log.Print("yesterday")
+	yesterday := time.Now().AddDate(0, 0, -1)
+	log.opened = yesterday
+	log.written = yesterday
+	log.Print("today")
+	is2.NoErr(log.Close())
+
+	archived, err := os.ReadFile(filepath.Join(dir, "app-"+yesterday.Format(rotatedTimeFormat)+".log"))
+	is2.NoErr(err)
+	is2.Equal(string(archived), "yesterday\n")
+}
+
+func TestRotatingFileLogger_PruneKeepsOtherFiles(t *testing.T) {
+	is2 := is.New(t)
+
+	dir := t.TempDir()
+	for _, name := range []string{"app-errors.log", "app-20230102-150405.x.log", "app-20230101-150405.log"} {
+		is2.NoErr(os.WriteFile(filepath.Join(dir, name), []byte("keep\n"), 0644))
+	}
+	log, err := NewRotatingFileLogger(RotatingOptions{Dir: dir, Filename: "app.log", MaxFiles: 1})
+	is2.NoErr(err)
+	log.maxSize = 100
+	for i := 0; i < 5; i++ {
+		log.Info(strings.Repeat("x", 40))
+	}
+	is2.NoErr(log.Close())
+
+	_, err = os.Stat(filepath.Join(dir, "app-errors.log"))
+	is2.NoErr(err)
+	_, err = os.Stat(filepath.Join(dir, "app-20230102-150405.x.log"))
+	is2.NoErr(err)
+	// The oldest archive is removed
+	_, err = os.Stat(filepath.Join(dir, "app-20230101-150405.log"))
+	is2.True(os.IsNotExist(err))
+}
+
+func TestRotatingFileLogger_ReopensWhenClosingFails(t *testing.T) {
+	is2 := is.New(t)
+
+	dir := t.TempDir()
+	var errs []error
+	log, err := NewRotatingFileLogger(RotatingOptions{Dir: dir, Filename: "app.log", OnError: func(err error) {
+		errs = append(errs, err)
+	}})
+	is2.NoErr(err)
+	log.maxSize = 100
+	log.Print(strings.Repeat("x", 40))
+	is2.NoErr(log.file.Close())
+	log.Print(strings.Repeat("y", 80))
+	log.Print("after")
+	is2.NoErr(log.Close())
+
+	is2.Equal(len(errs), 1)
+	is2.True(strings.Contains(errs[0].Error(), "unable to rotate log file"))
+	content, err := os.ReadFile(filepath.Join(dir, "app.log"))
+	is2.NoErr(err)
+	is2.Equal(string(content), strings.Repeat("y", 80)+"\nafter\n")
+}
+
+func TestRotatingFileLogger_FlushReturnsWriteErrors(t *testing.T) {
+	is2 := is.New(t)
+
+	log, err := NewRotatingFileLogger(RotatingOptions{Dir: t.TempDir(), Filename: "app.log"})
+	is2.NoErr(err)
+	is2.NoErr(log.file.Close())
+	log.Error("lost")
+
+	err = log.Flush()
+	is2.True(err != nil)
+	is2.True(strings.Contains(err.Error(), "unable to write to log file"))
+	_ = log.Close()
+}
+
+func TestArchiveOrder(t *testing.T) {
+	is2 := is.New(t)
+
+	timestamp, counter, ok := archiveOrder("app-20230102-150405.12.log.gz", "app", ".log")
+	is2.True(ok)
+	is2.Equal(timestamp, "20230102-150405")
+	is2.Equal(counter, 12)
+	timestamp, counter, ok = archiveOrder("app-20230102-150405.log", "app", ".log")
+	is2.True(ok)
+	is2.Equal(timestamp, "20230102-150405")
+	is2.Equal(counter, 0)
+
+	for _, name := range []string{"app.log", "app-errors.log", "app-20230102-150405.log.txt", "app-20230102-150405.0.log", "other-20230102-150405.log"} {
+		_, _, ok = archiveOrder(name, "app", ".log")
+		is2.True(!ok) // not an archive
+	}
+}
+
+func TestDefaultLogDirectory(t *testing.T) {
+	is2 := is.New(t)
+
+	if runtime.GOOS != "linux" {
+		t.Skip("XDG_STATE_HOME is only used on Linux")
+	}
+	t.Setenv("XDG_STATE_HOME", "/state")
+	dir, err := DefaultLogDirectory("app")
+	is2.NoErr(err)
+	is2.Equal(dir, "/state/app/logs")
+}
@@ -0,0 +1,117 @@
+//go:build go1.21
+// +build go1.21
+
+package logger
+
+import (
+	"context"
+	"log/slog"
+	"strconv"
+	"strings"
+)
+
+// SlogHandler is the log/slog handler that receives all logs if set in the application options
+type SlogHandler = slog.Handler
+
+const (
+	// SlogLevelTrace is the slog level used for Trace level logging
+	SlogLevelTrace = slog.LevelDebug - 4
+
+	// SlogLevelFatal is the slog level used for Fatal level logging
+	SlogLevelFatal = slog.LevelError + 4
+)
+
+// SlogLevel returns the slog level matching the log level
+func (l LogLevel) SlogLevel() slog.Level {
+	switch l {
+	case TRACE:
+		return SlogLevelTrace
+	case DEBUG:
+		return slog.LevelDebug
+	case WARNING:
+		return slog.LevelWarn
+	case ERROR:
+		return slog.LevelError
+	}
+	return slog.LevelInfo
+}
+
+// NewSlogHandler returns a slog.Handler that writes records to the given Logger.
+// Attributes are appended to the message as key=value pairs.
+// Filtering by level is left to the application's log level.
+func NewSlogHandler(logger Logger) slog.Handler {
+	return &slogHandler{logger: logger}
+}
+
+type slogHandler struct {
+	logger Logger
+	attrs  string // Preformatted attributes added with WithAttrs
+	group  string // Prefix for the keys of attributes, EG: "request."
+}
+
+func (h *slogHandler) Enabled(_ context.Context, _ slog.Level) bool {
+	return true
+}
+
+func (h *slogHandler) Handle(_ context.Context, record slog.Record) error {
+	var message strings.Builder
+	message.WriteString(record.Message)
+	message.WriteString(h.attrs)
+	record.Attrs(func(attr slog.Attr) bool {
+		writeAttr(&message, h.group, attr)
+		return true
+	})
+
+	switch {
+	case record.Level < slog.LevelDebug:
+		h.logger.Trace(message.String())
+	case record.Level < slog.LevelInfo:
+		h.logger.Debug(message.String())
+	case record.Level < slog.LevelWarn:
+		h.logger.Info(message.String())
+	case record.Level < slog.LevelError:
+		h.logger.Warning(message.String())
+	case record.Level < SlogLevelFatal:
+		h.logger.Error(message.String())
+	default:
+		h.logger.Fatal(message.String())
+	}
+	return nil
+}
+
+func (h *slogHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	var formatted strings.Builder
+	formatted.WriteString(h.attrs)
+	for _, attr := range attrs {
+		writeAttr(&formatted, h.group, attr)
+	}
+	return &slogHandler{logger: h.logger, attrs: formatted.String(), group: h.group}
+}
+
+func (h *slogHandler) WithGroup(name string) slog.Handler {
+	if name == "" {
+		return h
+	}
+	return &slogHandler{logger: h.logger, attrs: h.attrs, group: h.group + name + "."}
+}
+
+func writeAttr(message *strings.Builder, group string, attr slog.Attr) {
+	attr.Value = attr.Value.Resolve()
+	if attr.Equal(slog.Attr{}) {
+		return
+	}
+	if attr.Value.Kind() == slog.KindGroup {
+		if attr.Key != "" {
+			group += attr.Key + "."
+		}
+		for _, groupAttr := range attr.Value.Group() {
+			writeAttr(message, group, groupAttr)
+		}
+		return
+	}
+	value := attr.Value.String()
+	if value == "" || strings.ContainsAny(value, " \t\n\"=") {
+		value = strconv.Quote(value)
+	}
+	message.WriteString(" " + group + attr.Key + "=" + value)
+}
@@ -0,0 +1,79 @@
+//go:build go1.21
+// +build go1.21
+
+package logger
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+
+	"github.com/matryer/is"
+)
+
+// recordingLogger records the messages logged to it, prefixed with their level
+type recordingLogger struct {
+	messages []string
+}
+
+func (l *recordingLogger) Print(message string) { l.messages = append(l.messages, "PRINT "+message) }
+func (l *recordingLogger) Trace(message string) { l.messages = append(l.messages, "TRACE "+message) }
+func (l *recordingLogger) Debug(message string) { l.messages = append(l.messages, "DEBUG "+message) }
+func (l *recordingLogger) Info(message string)  { l.messages = append(l.messages, "INFO "+message) }
+func (l *recordingLogger) Warning(message string) {
+	l.messages = append(l.messages, "WARNING "+message)
+}
+func (l *recordingLogger) Error(message string) { l.messages = append(l.messages, "ERROR "+message) }
+func (l *recordingLogger) Fatal(message string) { l.messages = append(l.messages, "FATAL "+message) }
+
+func TestSlogHandler_Levels(t *testing.T) {
+	is2 := is.New(t)
+
+	output := &recordingLogger{}
+	log := slog.New(NewSlogHandler(output))
+	log.Log(context.Background(), SlogLevelTrace, "trace")
+	log.Debug("debug")
+	log.Info("info")
+	log.Warn("warn")
+	log.Error("error")
+	log.Log(context.Background(), SlogLevelFatal, "fatal")
+
+	is2.Equal(output.messages, []string{"TRACE trace", "DEBUG debug", "INFO info", "WARNING warn", "ERROR error", "FATAL fatal"})
+}
+
+func TestSlogHandler_Attrs(t *testing.T) {
+	tests := []struct {
+		name string
+		log  func(log *slog.Logger)
+		want string
+	}{
+		{"attrs", func(log *slog.Logger) { log.Info("message", "count", 3, "ok", true) }, "INFO message count=3 ok=true"},
+		{"quoted values", func(log *slog.Logger) { log.Info("message", "path", "a b", "empty", "", "eq", "a=b", "quote", `"`) }, `INFO message path="a b" empty="" eq="a=b" quote="\""`},
+		{"with attrs", func(log *slog.Logger) { log.With("id", 1).Info("message", "count", 3) }, "INFO message id=1 count=3"},
+		{"group", func(log *slog.Logger) { log.WithGroup("request").Info("message", "method", "GET") }, "INFO message request.method=GET"},
+		{"nested groups", func(log *slog.Logger) {
+			log.WithGroup("a").With("x", 1).WithGroup("b").Info("message", "y", 2)
+		}, "INFO message a.x=1 a.b.y=2"},
+		{"empty group name", func(log *slog.Logger) { log.WithGroup("").Info("message", "x", 1) }, "INFO message x=1"},
+		{"group attr", func(log *slog.Logger) { log.Info("message", slog.Group("user", "id", 7, "name", "ann")) }, "INFO message user.id=7 user.name=ann"},
+		{"inline group attr", func(log *slog.Logger) { log.Info("message", slog.Group("", "id", 7)) }, "INFO message id=7"},
+		{"empty attr", func(log *slog.Logger) { log.Info("message", slog.Attr{}, "x", 1) }, "INFO message x=1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			is2 := is.New(t)
+			output := &recordingLogger{}
+			tt.log(slog.New(NewSlogHandler(output)))
+			is2.Equal(output.messages, []string{tt.want})
+		})
+	}
+}
+
+func TestLogLevel_SlogLevel(t *testing.T) {
+	is2 := is.New(t)
+	is2.Equal(TRACE.SlogLevel(), SlogLevelTrace)
+	is2.Equal(DEBUG.SlogLevel(), slog.LevelDebug)
+	is2.Equal(INFO.SlogLevel(), slog.LevelInfo)
+	is2.Equal(WARNING.SlogLevel(), slog.LevelWarn)
+	is2.Equal(ERROR.SlogLevel(), slog.LevelError)
+}
@@ -0,0 +1,143 @@
+package menu
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/wailsapp/wails/v2/pkg/menu/keys"
+)
+
+// acceleratorPlatforms are the platforms the accelerators are checked for
+var acceleratorPlatforms = []string{"darwin", "linux", "windows"}
+
+// AcceleratorConflict is an accelerator used by more than one menu item on a platform
+type AcceleratorConflict struct {
+	// Platform where the menu items conflict, EG: "linux"
+	Platform string
+	// Accelerator as shown on the platform, EG: "Ctrl+S"
+	Accelerator string
+	// MenuItems that use the accelerator, in menu order
+	MenuItems []*MenuItem
+}
+
+func (c *AcceleratorConflict) String() string {
+	labels := make([]string, 0, len(c.MenuItems))
+	for _, item := range c.MenuItems {
+		labels = append(labels, "'"+itemLabel(item, c.Platform)+"'")
+	}
+	return fmt.Sprintf("%s is used by %s on %s", c.Accelerator, strings.Join(labels, ", "), c.Platform)
+}
+
+// KeymapEntry is a menu item with an accelerator
+type KeymapEntry struct {
+	// Path holds the labels of the submenus and the menu item, EG: ["File", "Save"]
+	Path []string
+	// Accelerators as shown on each platform, EG: "linux": "Ctrl+S".
+	// Platforms where the item has no accelerator are left out.
+	Accelerators map[string]string
+}
+
+// acceleratorItem is a menu item with an accelerator on a platform
+type acceleratorItem struct {
+	// key identifies the item on all platforms, as the labels of roles depend on the platform
+	key         string
+	path        []string
+	item        *MenuItem
+	accelerator *keys.Accelerator
+}
+
+// ValidateAccelerators returns the accelerators used by more than one item of the menu, including its submenus.
+// CmdOrCtrl is resolved for each platform, so "CmdOrCtrl+S" and "Ctrl+S" conflict on Linux and Windows
+// but not on Mac. The accelerators of roles are included and hidden items are ignored.
+func ValidateAccelerators(menu *Menu) []*AcceleratorConflict {
+	var result []*AcceleratorConflict
+	for _, platform := range acceleratorPlatforms {
+		var order []string
+		menuItems := make(map[string][]*MenuItem)
+		for _, entry := range acceleratorItems(menu, platform, "", nil, nil) {
+			accelerator := keys.Stringify(entry.accelerator, platform)
+			if _, exists := menuItems[accelerator]; !exists {
+				order = append(order, accelerator)
+			}
+			menuItems[accelerator] = append(menuItems[accelerator], entry.item)
+		}
+		for _, accelerator := range order {
+			if len(menuItems[accelerator]) > 1 {
+				result = append(result, &AcceleratorConflict{
+					Platform:    platform,
+					Accelerator: accelerator,
+					MenuItems:   menuItems[accelerator],
+				})
+			}
+		}
+	}
+	return result
+}
+
+// Keymap returns the items of the menu that have an accelerator on any platform, in menu order.
+// The accelerators of roles are included and hidden items are ignored.
+func Keymap(menu *Menu) []*KeymapEntry {
+	var result []*KeymapEntry
+	entries := make(map[string]*KeymapEntry)
+	for _, platform := range acceleratorPlatforms {
+		for _, item := range acceleratorItems(menu, platform, "", nil, nil) {
+			entry := entries[item.key]
+			if entry == nil {
+				entry = &KeymapEntry{
+					Path:         item.path,
+					Accelerators: make(map[string]string),
+				}
+				entries[item.key] = entry
+				result = append(result, entry)
+			}
+			entry.Accelerators[platform] = keys.Stringify(item.accelerator, platform)
+		}
+	}
+	return result
+}
+
+// acceleratorItems returns the visible items of the menu with an accelerator on the platform
+func acceleratorItems(menu *Menu, platform string, key string, path []string, result []*acceleratorItem) []*acceleratorItem {
+	if menu == nil {
+		return result
+	}
+	for _, item := range menu.Items {
+		if item.Hidden {
+			continue
+		}
+		label := itemLabel(item, platform)
+		itemKey := key + "\x00" + label
+		accelerator := item.Accelerator
+		submenu := item.SubMenu
+		if item.Role != 0 {
+			if item.Role.macOnly() && platform != "darwin" {
+				continue
+			}
+			itemKey = fmt.Sprintf("%s\x00%d", key, item.Role)
+			accelerator = item.Role.accelerator(platform)
+			submenu = item.Role.menu(platform)
+		}
+		itemPath := append(append([]string{}, path...), label)
+		if accelerator != nil {
+			result = append(result, &acceleratorItem{
+				key:         itemKey,
+				path:        itemPath,
+				item:        item,
+				accelerator: keys.Resolve(accelerator, platform),
+			})
+		}
+		result = acceleratorItems(submenu, platform, itemKey, itemPath, result)
+	}
+	return result
+}
+
+// itemLabel returns the label of the menu item on the platform
+func itemLabel(item *MenuItem, platform string) string {
+	if item.Label != "" {
+		return item.Label
+	}
+	if item.Role != 0 {
+		return item.Role.label(platform)
+	}
+	return item.ID
+}
@@ -0,0 +1,64 @@
+package menu
+
+import (
+	"testing"
+
+	"github.com/matryer/is"
+	"github.com/wailsapp/wails/v2/pkg/menu/keys"
+)
+
+func TestValidateAccelerators(t *testing.T) {
+	is2 := is.New(t)
+
+	save := Text("Save", keys.CmdOrCtrl("s"), nil)
+	saveAll := Text("Save All", &keys.Accelerator{Key: "S", Modifiers: []keys.Modifier{keys.ControlKey}}, nil)
+	find := Text("Find", keys.Combo("f", keys.ShiftKey, keys.CmdOrCtrlKey), nil)
+	findNext := Text("Find Next", keys.Combo("f", keys.CmdOrCtrlKey, keys.ShiftKey), nil)
+	hidden := &MenuItem{Label: "Hidden", Type: TextType, Accelerator: keys.CmdOrCtrl("s"), Hidden: true}
+	copyAll := Text("Copy All", keys.CmdOrCtrl("c"), nil)
+
+	fileMenu := NewMenuFromItems(save, saveAll, find, findNext, hidden)
+	editMenu := NewMenuFromItems(copyAll)
+	appMenu := NewMenuFromItems(SubMenu("File", fileMenu), EditMenu(), SubMenu("Tools", editMenu))
+
+	conflicts := ValidateAccelerators(appMenu)
+	var result []string
+	for _, conflict := range conflicts {
+		result = append(result, conflict.String())
+	}
+	is2.Equal(result, []string{
+		"Cmd+Shift+F is used by 'Find', 'Find Next' on darwin",
+		"Cmd+C is used by 'Copy', 'Copy All' on darwin",
+		"Ctrl+S is used by 'Save', 'Save All' on linux",
+		"Ctrl+Shift+F is used by 'Find', 'Find Next' on linux",
+		"Ctrl+C is used by 'Copy', 'Copy All' on linux",
+		"Ctrl+S is used by 'Save', 'Save All' on windows",
+		"Ctrl+Shift+F is used by 'Find', 'Find Next' on windows",
+		"Ctrl+C is used by 'Copy', 'Copy All' on windows",
+	})
+	is2.Equal(conflicts[0].MenuItems, []*MenuItem{find, findNext})
+
+	is2.Equal(len(ValidateAccelerators(NewMenuFromItems(EditMenu(), WindowMenu(), ViewMenu()))), 0)
+}
+
+func TestKeymap(t *testing.T) {
+	is2 := is.New(t)
+
+	appMenu := NewMenuFromItems(
+		AppMenu(),
+		SubMenu("File", NewMenuFromItems(
+			Text("Save", keys.CmdOrCtrl("s"), nil),
+			Text("Open", nil, nil),
+			Quit(),
+		)),
+		SubMenu("Window", NewMenuFromItems(Hide(), Minimize(), ToggleFullscreen())),
+	)
+
+	is2.Equal(Keymap(appMenu), []*KeymapEntry{
+		{Path: []string{"File", "Save"}, Accelerators: map[string]string{"darwin": "Cmd+S", "linux": "Ctrl+S", "windows": "Ctrl+S"}},
+		{Path: []string{"File", "Quit"}, Accelerators: map[string]string{"darwin": "Cmd+Q", "linux": "Ctrl+Q"}},
+		{Path: []string{"Window", "Hide"}, Accelerators: map[string]string{"darwin": "Cmd+H"}},
+		{Path: []string{"Window", "Minimize"}, Accelerators: map[string]string{"darwin": "Cmd+M", "linux": "Ctrl+M", "windows": "Ctrl+M"}},
+		{Path: []string{"Window", "Toggle Full Screen"}, Accelerators: map[string]string{"darwin": "Cmd+Ctrl+F", "linux": "F11", "windows": "F11"}},
+	})
+}
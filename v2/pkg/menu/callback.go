@@ -2,7 +2,9 @@ package menu
 
 type CallbackData struct {
 	MenuItem *MenuItem
-	//ContextData string
+	// ContextData is the value of the --wails-contextmenu-data CSS property of the element
+	// a context menu was opened on. Empty for other menus.
+	ContextData string
 }
 
 type Callback func(*CallbackData)
@@ -0,0 +1,25 @@
+package keys
+
+import "strings"
+
+// modifierOrder is the order of the modifiers of a resolved accelerator
+var modifierOrder = []Modifier{CmdOrCtrlKey, ControlKey, OptionOrAltKey, ShiftKey}
+
+// Resolve returns the keys pressed for the accelerator on the platform, with the modifiers in
+// a fixed order. Accelerators for the same keys resolve to the same accelerator, EG:
+// "Shift+CmdOrCtrl+P" and "Ctrl+Shift+P" on Linux.
+func Resolve(accelerator *Accelerator, platform string) *Accelerator {
+	result := &Accelerator{Key: strings.ToLower(accelerator.Key)}
+	for _, modifier := range modifierOrder {
+		for _, pressed := range accelerator.Modifiers {
+			if pressed == CmdOrCtrlKey && platform != "darwin" {
+				pressed = ControlKey
+			}
+			if pressed == modifier {
+				result.Modifiers = append(result.Modifiers, modifier)
+				break
+			}
+		}
+	}
+	return result
+}
@@ -0,0 +1,27 @@
+package keys
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestResolve(t *testing.T) {
+	tests := []struct {
+		arg      *Accelerator
+		platform string
+		want     *Accelerator
+	}{
+		{Key("A"), "linux", &Accelerator{Key: "a"}},
+		{Combo("p", ShiftKey, CmdOrCtrlKey), "linux", &Accelerator{Key: "p", Modifiers: []Modifier{ControlKey, ShiftKey}}},
+		{Combo("p", ShiftKey, CmdOrCtrlKey), "darwin", &Accelerator{Key: "p", Modifiers: []Modifier{CmdOrCtrlKey, ShiftKey}}},
+		{Combo("p", ControlKey, CmdOrCtrlKey), "windows", &Accelerator{Key: "p", Modifiers: []Modifier{ControlKey}}},
+		{Combo("p", ControlKey, CmdOrCtrlKey), "darwin", &Accelerator{Key: "p", Modifiers: []Modifier{CmdOrCtrlKey, ControlKey}}},
+	}
+	for _, tt := range tests {
+		t.Run(Stringify(tt.arg, tt.platform), func(t *testing.T) {
+			if got := Resolve(tt.arg, tt.platform); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Resolve() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,209 @@
+package menu
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/wailsapp/wails/v2/pkg/menu/keys"
+	"gopkg.in/yaml.v3"
+)
+
+// menuDefinition is a menu as defined in JSON or YAML
+type menuDefinition struct {
+	Items []*menuItemDefinition `json:"items" yaml:"items"`
+}
+
+// menuItemDefinition is a menu item as defined in JSON or YAML
+type menuItemDefinition struct {
+	ID          string                `json:"id" yaml:"id"`
+	Label       string                `json:"label" yaml:"label"`
+	LabelKey    string                `json:"labelKey" yaml:"labelKey"`
+	Type        string                `json:"type" yaml:"type"`
+	Role        string                `json:"role" yaml:"role"`
+	Accelerator string                `json:"accelerator" yaml:"accelerator"`
+	Click       string                `json:"click" yaml:"click"`
+	Checked     bool                  `json:"checked" yaml:"checked"`
+	Disabled    bool                  `json:"disabled" yaml:"disabled"`
+	Hidden      bool                  `json:"hidden" yaml:"hidden"`
+	Tooltip     string                `json:"tooltip" yaml:"tooltip"`
+	Sublabel    string                `json:"sublabel" yaml:"sublabel"`
+	SubMenu     []*menuItemDefinition `json:"submenu" yaml:"submenu"`
+}
+
+var typeNames = map[string]Type{
+	"text":      TextType,
+	"separator": SeparatorType,
+	"submenu":   SubmenuType,
+	"checkbox":  CheckboxType,
+	"radio":     RadioType,
+}
+
+// roleNames are the names of the roles in lowercase
+var roleNames = map[string]Role{
+	"appmenu":            AppMenuRole,
+	"editmenu":           EditMenuRole,
+	"windowmenu":         WindowMenuRole,
+	"filemenu":           FileMenuRole,
+	"viewmenu":           ViewMenuRole,
+	"helpmenu":           HelpMenuRole,
+	"about":              AboutRole,
+	"undo":               UndoRole,
+	"redo":               RedoRole,
+	"cut":                CutRole,
+	"copy":               CopyRole,
+	"paste":              PasteRole,
+	"pasteandmatchstyle": PasteAndMatchStyleRole,
+	"selectall":          SelectAllRole,
+	"delete":             DeleteRole,
+	"minimize":           MinimizeRole,
+	"zoom":               ZoomRole,
+	"togglefullscreen":   ToggleFullscreenRole,
+	"reload":             ReloadRole,
+	"closewindow":        CloseWindowRole,
+	"quit":               QuitRole,
+	"hide":               HideRole,
+	"hideothers":         HideOthersRole,
+	"unhide":             UnhideRole,
+	"front":              FrontRole,
+}
+
+// LoadFromJSON creates a menu from its JSON definition. The click callbacks of the menu items
+// are looked up by name in handlers.
+func LoadFromJSON(r io.Reader, handlers map[string]Callback) (*Menu, error) {
+	var definition menuDefinition
+	decoder := json.NewDecoder(r)
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&definition); err != nil {
+		return nil, fmt.Errorf("invalid menu definition: %w", err)
+	}
+	return newMenuFromDefinition(&definition, handlers)
+}
+
+// LoadFromYAML creates a menu from its YAML definition. The click callbacks of the menu items
+// are looked up by name in handlers.
+func LoadFromYAML(r io.Reader, handlers map[string]Callback) (*Menu, error) {
+	var definition menuDefinition
+	decoder := yaml.NewDecoder(r)
+	decoder.KnownFields(true)
+	if err := decoder.Decode(&definition); err != nil {
+		return nil, fmt.Errorf("invalid menu definition: %w", err)
+	}
+	return newMenuFromDefinition(&definition, handlers)
+}
+
+func newMenuFromDefinition(definition *menuDefinition, handlers map[string]Callback) (*Menu, error) {
+	result, err := processMenuDefinition(definition.Items, handlers)
+	if err != nil {
+		return nil, err
+	}
+
+	// Radio groups need exactly one checked item
+	for _, group := range result.RadioGroups() {
+		var checked []*MenuItem
+		for _, item := range group {
+			if item.Checked {
+				checked = append(checked, item)
+			}
+		}
+		switch len(checked) {
+		case 0:
+			group[0].Checked = true
+		case 1:
+		default:
+			return nil, fmt.Errorf("invalid menu definition: radio items '%s' and '%s' are both checked", itemName(checked[0]), itemName(checked[1]))
+		}
+	}
+
+	return result, nil
+}
+
+func processMenuDefinition(items []*menuItemDefinition, handlers map[string]Callback) (*Menu, error) {
+	result := NewMenu()
+	for _, definition := range items {
+		item, err := processMenuItemDefinition(definition, handlers)
+		if err != nil {
+			return nil, err
+		}
+		result.Append(item)
+	}
+	return result, nil
+}
+
+func processMenuItemDefinition(definition *menuItemDefinition, handlers map[string]Callback) (*MenuItem, error) {
+	result := &MenuItem{
+		ID:       definition.ID,
+		Label:    definition.Label,
+		LabelKey: definition.LabelKey,
+		Checked:  definition.Checked,
+		Disabled: definition.Disabled,
+		Hidden:   definition.Hidden,
+		Tooltip:  definition.Tooltip,
+		Sublabel: definition.Sublabel,
+	}
+
+	// Until the menu is translated, the label key is shown
+	if result.Label == "" {
+		result.Label = result.LabelKey
+	}
+
+	name := itemName(result)
+
+	if definition.Role != "" {
+		role, ok := roleNames[strings.ToLower(definition.Role)]
+		if !ok {
+			return nil, fmt.Errorf("invalid menu definition: unknown role '%s'", definition.Role)
+		}
+		result.Role = role
+		return result, nil
+	}
+
+	switch {
+	case definition.Type != "":
+		menuType, ok := typeNames[strings.ToLower(definition.Type)]
+		if !ok {
+			return nil, fmt.Errorf("invalid menu definition: unknown type '%s' for menu item '%s'", definition.Type, name)
+		}
+		result.Type = menuType
+	case definition.SubMenu != nil:
+		result.Type = SubmenuType
+	default:
+		result.Type = TextType
+	}
+
+	if definition.Accelerator != "" {
+		accelerator, err := keys.Parse(definition.Accelerator)
+		if err != nil {
+			return nil, fmt.Errorf("invalid menu definition: invalid accelerator for menu item '%s': %w", name, err)
+		}
+		result.Accelerator = accelerator
+	}
+
+	if definition.Click != "" {
+		click, ok := handlers[definition.Click]
+		if !ok {
+			return nil, fmt.Errorf("invalid menu definition: unknown handler '%s' for menu item '%s'", definition.Click, name)
+		}
+		result.Click = click
+	}
+
+	if result.Type == SubmenuType {
+		submenu, err := processMenuDefinition(definition.SubMenu, handlers)
+		if err != nil {
+			return nil, err
+		}
+		result.SubMenu = submenu
+		submenu.setParent(result)
+	}
+
+	return result, nil
+}
+
+// itemName returns the ID or label of the menu item for error messages
+func itemName(item *MenuItem) string {
+	if item.ID != "" {
+		return item.ID
+	}
+	return item.Label
+}
@@ -0,0 +1,144 @@
+package menu
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/matryer/is"
+	"github.com/wailsapp/wails/v2/pkg/menu/keys"
+)
+
+const testMenuJSON = `{
+	"items": [
+		{"role": "appMenu"},
+		{"labelKey": "menu.file", "submenu": [
+			{"id": "open", "labelKey": "menu.open", "accelerator": "CmdOrCtrl+O", "click": "open"},
+			{"type": "separator"},
+			{"id": "autosave", "label": "Autosave", "type": "checkbox", "checked": true, "click": "autosave"},
+			{"role": "quit"}
+		]},
+		{"label": "Theme", "submenu": [
+			{"id": "light", "label": "Light", "type": "radio", "click": "theme"},
+			{"id": "dark", "label": "Dark", "type": "radio", "click": "theme"}
+		]}
+	]
+}`
+
+const testMenuYAML = `
+items:
+  - role: appMenu
+  - labelKey: menu.file
+    submenu:
+      - id: open
+        labelKey: menu.open
+        accelerator: CmdOrCtrl+O
+        click: open
+      - type: separator
+      - id: autosave
+        label: Autosave
+        type: checkbox
+        checked: true
+        click: autosave
+      - role: quit
+  - label: Theme
+    submenu:
+      - id: light
+        label: Light
+        type: radio
+        click: theme
+      - id: dark
+        label: Dark
+        type: radio
+        click: theme
+`
+
+func TestLoad(t *testing.T) {
+	handlers := map[string]Callback{
+		"open":     func(*CallbackData) {},
+		"autosave": func(*CallbackData) {},
+		"theme":    func(*CallbackData) {},
+	}
+
+	tests := []struct {
+		name string
+		load func() (*Menu, error)
+	}{
+		{"json", func() (*Menu, error) { return LoadFromJSON(strings.NewReader(testMenuJSON), handlers) }},
+		{"yaml", func() (*Menu, error) { return LoadFromYAML(strings.NewReader(testMenuYAML), handlers) }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			is2 := is.New(t)
+			result, err := tt.load()
+			is2.NoErr(err)
+
+			is2.Equal(len(result.Items), 3)
+			is2.Equal(result.Items[0].Role, AppMenuRole)
+
+			file := result.Items[1]
+			is2.Equal(file.Type, SubmenuType)
+			is2.Equal(file.Label, "menu.file")
+			is2.Equal(roles(file.SubMenu), []Role{0, 0, 0, QuitRole})
+
+			open := result.Find("open")
+			is2.True(open != nil)
+			is2.Equal(open.Type, TextType)
+			is2.Equal(open.Accelerator, keys.CmdOrCtrl("o"))
+			is2.True(open.Click != nil)
+			is2.Equal(open.Parent(), file)
+			is2.Equal(file.SubMenu.Items[1].Type, SeparatorType)
+
+			autosave := result.Find("autosave")
+			is2.Equal(autosave.Type, CheckboxType)
+			is2.True(autosave.Checked)
+
+			// The first item of a radio group without a checked item is checked
+			is2.True(result.Find("light").Checked)
+			is2.True(!result.Find("dark").Checked)
+			is2.True(result.Find("missing") == nil)
+
+			result.Translate(func(key string) string {
+				return map[string]string{"menu.file": "Datei", "menu.open": "Öffnen"}[key]
+			})
+			is2.Equal(file.Label, "Datei")
+			is2.Equal(open.Label, "Öffnen")
+			is2.Equal(autosave.Label, "Autosave")
+		})
+	}
+}
+
+func TestLoadErrors(t *testing.T) {
+	tests := []struct {
+		name       string
+		definition string
+		want       string
+	}{
+		{"unknown field", `{"items": [{"lable": "File"}]}`, `unknown field "lable"`},
+		{"unknown role", `{"items": [{"role": "paste-special"}]}`, "unknown role 'paste-special'"},
+		{"unknown type", `{"items": [{"label": "File", "type": "button"}]}`, "unknown type 'button' for menu item 'File'"},
+		{"unknown handler", `{"items": [{"id": "open", "click": "open"}]}`, "unknown handler 'open' for menu item 'open'"},
+		{"invalid accelerator", `{"items": [{"label": "Open", "accelerator": "Hyper+O"}]}`, "invalid accelerator for menu item 'Open'"},
+		{"radio group", `{"items": [{"id": "a", "type": "radio", "checked": true}, {"id": "b", "type": "radio", "checked": true}]}`, "radio items 'a' and 'b' are both checked"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			is2 := is.New(t)
+			_, err := LoadFromJSON(strings.NewReader(tt.definition), nil)
+			is2.True(err != nil)
+			is2.True(strings.Contains(err.Error(), tt.want))
+		})
+	}
+}
+
+func TestRadioGroups(t *testing.T) {
+	is2 := is.New(t)
+
+	a := Radio("a", true, nil, nil)
+	b := Radio("b", false, nil, nil)
+	c := Radio("c", true, nil, nil)
+	d := Radio("d", true, nil, nil)
+	submenu := NewMenuFromItems(c)
+	result := NewMenuFromItems(a, b, SubMenu("Sub", submenu), d)
+
+	is2.Equal(result.RadioGroups(), [][]*MenuItem{{a, b}, {c}, {d}})
+}
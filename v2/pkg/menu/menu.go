@@ -69,6 +69,38 @@ func NewMenuFromItems(first *MenuItem, rest ...*MenuItem) *Menu {
 	return result
 }
 
+// Find returns the menu item with the given ID, searching submenus as well.
+// Returns nil if there is no menu item with the ID.
+func (m *Menu) Find(id string) *MenuItem {
+	for _, item := range m.Items {
+		if item.ID == id {
+			return item
+		}
+		if item.SubMenu != nil {
+			if result := item.SubMenu.Find(id); result != nil {
+				return result
+			}
+		}
+	}
+	return nil
+}
+
+// Translator returns the label for a label key, EG: the label in the language of the user
+type Translator func(key string) string
+
+// Translate sets the labels of the menu items that have a LabelKey using the translator.
+// Call it again and update the menu to change the language of the menu.
+func (m *Menu) Translate(translator Translator) {
+	for _, item := range m.Items {
+		if item.LabelKey != "" {
+			item.Label = translator(item.LabelKey)
+		}
+		if item.SubMenu != nil {
+			item.SubMenu.Translate(translator)
+		}
+	}
+}
+
 func (m *Menu) setParent(menuItem *MenuItem) {
 	for _, item := range m.Items {
 		item.parent = menuItem
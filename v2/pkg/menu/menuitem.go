@@ -8,8 +8,12 @@ import (
 
 // MenuItem represents a menuitem contained in a menu
 type MenuItem struct {
+	// ID identifies the menu item, EG: to find it with Menu.Find
+	ID string
 	// Label is what appears as the menu text
 	Label string
+	// LabelKey is the key used to look up the label with Menu.Translate
+	LabelKey string
 	// Role is a predefined menu type
 	Role Role
 	// Accelerator holds a representation of a key binding
@@ -28,6 +32,15 @@ type MenuItem struct {
 
 	// Callback function when menu clicked
 	Click Callback
+
+	// Icon is shown next to the label. PNG image data
+	Icon []byte
+	// IconDark is shown instead of Icon when a dark theme is used. PNG image data
+	IconDark []byte
+	// Tooltip is shown when the mouse hovers over the item
+	Tooltip string
+	// Sublabel is secondary text shown below the label
+	Sublabel string
 	/*
 		// Text Colour
 		RGBA string
@@ -36,17 +49,11 @@ type MenuItem struct {
 		FontSize int
 		FontName string
 
-		// Image - base64 image data
-		Image string
-
 		// MacTemplateImage indicates that on a Mac, this image is a template image
 		MacTemplateImage bool
 
 		// MacAlternate indicates that this item is an alternative to the previous menu item
 		MacAlternate bool
-
-		// Tooltip
-		Tooltip string
 	*/
 	// This holds the menu item's parent.
 	parent *MenuItem
@@ -3,212 +3,347 @@
 // Electron License: https://github.com/electron/electron/blob/master/LICENSE
 package menu
 
+import (
+	"runtime"
+
+	"github.com/wailsapp/wails/v2/pkg/menu/keys"
+)
+
 // Role is a type to identify menu roles
 type Role int
 
 // These constants need to be kept in sync with `v2/internal/frontend/desktop/darwin/Role.h`
+// EditMenuRole and WindowMenuRole are untyped for backwards compatibility.
 const (
 	AppMenuRole    Role = 1
 	EditMenuRole        = 2
 	WindowMenuRole      = 3
-	//AboutRole              Role = "about"
-	//UndoRole               Role = "undo"
-	//RedoRole               Role = "redo"
-	//CutRole                Role = "cut"
-	//CopyRole               Role = "copy"
-	//PasteRole              Role = "paste"
-	//PasteAndMatchStyleRole Role = "pasteAndMatchStyle"
-	//SelectAllRole          Role = "selectAll"
-	//DeleteRole             Role = "delete"
-	//MinimizeRole           Role = "minimize"
-	//QuitRole               Role = "quit"
-	//TogglefullscreenRole   Role = "togglefullscreen"
-	//FileMenuRole           Role = "fileMenu"
-	//ViewMenuRole           Role = "viewMenu"
-	//WindowMenuRole         Role = "windowMenu"
-	//HideRole               Role = "hide"
-	//HideOthersRole         Role = "hideOthers"
-	//UnhideRole             Role = "unhide"
-	//FrontRole              Role = "front"
-	//ZoomRole               Role = "zoom"
-	//WindowSubMenuRole      Role = "windowSubMenu"
-	//HelpSubMenuRole        Role = "helpSubMenu"
-	//SeparatorItemRole      Role = "separatorItem"
+	FileMenuRole   Role = 4
+	ViewMenuRole   Role = 5
+	HelpMenuRole   Role = 6
+
+	AboutRole              Role = 7
+	UndoRole               Role = 8
+	RedoRole               Role = 9
+	CutRole                Role = 10
+	CopyRole               Role = 11
+	PasteRole              Role = 12
+	PasteAndMatchStyleRole Role = 13
+	SelectAllRole          Role = 14
+	DeleteRole             Role = 15
+	MinimizeRole           Role = 16
+	ZoomRole               Role = 17
+	ToggleFullscreenRole   Role = 18
+	ReloadRole             Role = 19
+	CloseWindowRole        Role = 20
+	QuitRole               Role = 21
+
+	// These roles are Mac only
+	HideRole       Role = 22
+	HideOthersRole Role = 23
+	UnhideRole     Role = 24
+	FrontRole      Role = 25
 )
 
-/*
-// About provides a MenuItem with the About role
-func About() *MenuItem {
+// goos is the platform used for the default labels and accelerators of the roles
+var goos = runtime.GOOS
+
+// IsMenu returns true if the role provides a whole submenu, EG: EditMenuRole
+func (r Role) IsMenu() bool {
+	return r >= AppMenuRole && r <= HelpMenuRole
+}
+
+// macOnly returns true if the role is only available on Mac
+func (r Role) macOnly() bool {
+	return r == AppMenuRole || r >= HideRole
+}
+
+// Label returns the default label of the role on the current platform
+func (r Role) Label() string {
+	return r.label(goos)
+}
+
+func (r Role) label(platform string) string {
+	switch r {
+	case EditMenuRole:
+		return "Edit"
+	case WindowMenuRole:
+		return "Window"
+	case FileMenuRole:
+		return "File"
+	case ViewMenuRole:
+		return "View"
+	case HelpMenuRole:
+		return "Help"
+	case AboutRole:
+		return "About"
+	case UndoRole:
+		return "Undo"
+	case RedoRole:
+		return "Redo"
+	case CutRole:
+		return "Cut"
+	case CopyRole:
+		return "Copy"
+	case PasteRole:
+		return "Paste"
+	case PasteAndMatchStyleRole:
+		return "Paste and Match Style"
+	case SelectAllRole:
+		return "Select All"
+	case DeleteRole:
+		return "Delete"
+	case MinimizeRole:
+		if platform == "darwin" {
+			return "Minimize"
+		}
+		return "Minimise"
+	case ZoomRole:
+		if platform == "darwin" {
+			return "Zoom"
+		}
+		return "Maximise"
+	case ToggleFullscreenRole:
+		return "Toggle Full Screen"
+	case ReloadRole:
+		return "Reload"
+	case CloseWindowRole:
+		return "Close Window"
+	case QuitRole:
+		if platform == "windows" {
+			return "Exit"
+		}
+		return "Quit"
+	case HideRole:
+		return "Hide"
+	case HideOthersRole:
+		return "Hide Others"
+	case UnhideRole:
+		return "Show All"
+	case FrontRole:
+		return "Bring All to Front"
+	}
+	return ""
+}
+
+// Accelerator returns the default accelerator of the role on the current platform.
+// Returns nil if the role has no accelerator.
+func (r Role) Accelerator() *keys.Accelerator {
+	return r.accelerator(goos)
+}
+
+func (r Role) accelerator(platform string) *keys.Accelerator {
+	switch r {
+	case UndoRole:
+		return keys.CmdOrCtrl("z")
+	case RedoRole:
+		if platform == "windows" {
+			return keys.CmdOrCtrl("y")
+		}
+		return keys.Combo("z", keys.CmdOrCtrlKey, keys.ShiftKey)
+	case CutRole:
+		return keys.CmdOrCtrl("x")
+	case CopyRole:
+		return keys.CmdOrCtrl("c")
+	case PasteRole:
+		return keys.CmdOrCtrl("v")
+	case PasteAndMatchStyleRole:
+		return keys.Combo("v", keys.CmdOrCtrlKey, keys.OptionOrAltKey, keys.ShiftKey)
+	case SelectAllRole:
+		return keys.CmdOrCtrl("a")
+	case MinimizeRole:
+		return keys.CmdOrCtrl("m")
+	case ToggleFullscreenRole:
+		if platform == "darwin" {
+			return keys.Combo("f", keys.CmdOrCtrlKey, keys.ControlKey)
+		}
+		return keys.Key("f11")
+	case ReloadRole:
+		return keys.CmdOrCtrl("r")
+	case CloseWindowRole:
+		return keys.CmdOrCtrl("w")
+	case QuitRole:
+		if platform == "windows" {
+			return nil
+		}
+		return keys.CmdOrCtrl("q")
+	case HideRole:
+		return keys.CmdOrCtrl("h")
+	case HideOthersRole:
+		return keys.Combo("h", keys.CmdOrCtrlKey, keys.OptionOrAltKey)
+	}
+	return nil
+}
+
+// Menu returns the default items of a menu role on the current platform.
+// Returns nil if the role is not a menu role or if the menu is only available on Mac.
+func (r Role) Menu() *Menu {
+	return r.menu(goos)
+}
+
+func (r Role) menu(platform string) *Menu {
+	switch r {
+	case FileMenuRole:
+		if platform == "darwin" {
+			return NewMenuFromItems(CloseWindow())
+		}
+		return NewMenuFromItems(Quit())
+	case EditMenuRole:
+		result := NewMenuFromItems(Undo(), Redo(), Separator(), Cut(), Copy(), Paste())
+		if platform == "darwin" {
+			result.Append(PasteAndMatchStyle())
+		}
+		result.Append(Delete())
+		result.Append(Separator())
+		result.Append(SelectAll())
+		return result
+	case ViewMenuRole:
+		return NewMenuFromItems(Reload(), Separator(), ToggleFullscreen())
+	case WindowMenuRole:
+		result := NewMenuFromItems(Minimize(), Zoom())
+		if platform == "darwin" {
+			result.Append(Separator())
+			result.Append(Front())
+		} else {
+			result.Append(CloseWindow())
+		}
+		return result
+	case HelpMenuRole:
+		return NewMenuFromItems(About())
+	}
+	return nil
+}
+
+func roleMenuItem(role Role) *MenuItem {
 	return &MenuItem{
-		Role: AboutRole,
+		Role: role,
 	}
 }
 
+// About provides a MenuItem with the About role
+func About() *MenuItem {
+	return roleMenuItem(AboutRole)
+}
+
 // Undo provides a MenuItem with the Undo role
 func Undo() *MenuItem {
-	return &MenuItem{
-		Role: UndoRole,
-	}
+	return roleMenuItem(UndoRole)
 }
 
 // Redo provides a MenuItem with the Redo role
 func Redo() *MenuItem {
-	return &MenuItem{
-		Role: RedoRole,
-	}
+	return roleMenuItem(RedoRole)
 }
 
 // Cut provides a MenuItem with the Cut role
 func Cut() *MenuItem {
-	return &MenuItem{
-		Role: CutRole,
-	}
+	return roleMenuItem(CutRole)
 }
 
 // Copy provides a MenuItem with the Copy role
 func Copy() *MenuItem {
-	return &MenuItem{
-		Role: CopyRole,
-	}
+	return roleMenuItem(CopyRole)
 }
 
 // Paste provides a MenuItem with the Paste role
 func Paste() *MenuItem {
-	return &MenuItem{
-		Role: PasteRole,
-	}
+	return roleMenuItem(PasteRole)
 }
 
 // PasteAndMatchStyle provides a MenuItem with the PasteAndMatchStyle role
 func PasteAndMatchStyle() *MenuItem {
-	return &MenuItem{
-		Role: PasteAndMatchStyleRole,
-	}
+	return roleMenuItem(PasteAndMatchStyleRole)
 }
 
 // SelectAll provides a MenuItem with the SelectAll role
 func SelectAll() *MenuItem {
-	return &MenuItem{
-		Role: SelectAllRole,
-	}
+	return roleMenuItem(SelectAllRole)
 }
 
 // Delete provides a MenuItem with the Delete role
 func Delete() *MenuItem {
-	return &MenuItem{
-		Role: DeleteRole,
-	}
+	return roleMenuItem(DeleteRole)
 }
 
 // Minimize provides a MenuItem with the Minimize role
 func Minimize() *MenuItem {
-	return &MenuItem{
-		Role: MinimizeRole,
-	}
+	return roleMenuItem(MinimizeRole)
 }
 
-// Quit provides a MenuItem with the Quit role
-func Quit() *MenuItem {
-	return &MenuItem{
-		Role: QuitRole,
-	}
+// Zoom provides a MenuItem with the Zoom role. It toggles between the maximised and normal window size.
+func Zoom() *MenuItem {
+	return roleMenuItem(ZoomRole)
 }
 
 // ToggleFullscreen provides a MenuItem with the ToggleFullscreen role
 func ToggleFullscreen() *MenuItem {
-	return &MenuItem{
-		Role: TogglefullscreenRole,
-	}
+	return roleMenuItem(ToggleFullscreenRole)
+}
+
+// Reload provides a MenuItem with the Reload role
+func Reload() *MenuItem {
+	return roleMenuItem(ReloadRole)
+}
+
+// CloseWindow provides a MenuItem with the CloseWindow role
+func CloseWindow() *MenuItem {
+	return roleMenuItem(CloseWindowRole)
+}
+
+// Quit provides a MenuItem with the Quit role
+func Quit() *MenuItem {
+	return roleMenuItem(QuitRole)
 }
 
 // FileMenu provides a MenuItem with the whole default "File" menu (Close / Quit)
 func FileMenu() *MenuItem {
-	return &MenuItem{
-		Role: FileMenuRole,
-	}
+	return roleMenuItem(FileMenuRole)
 }
-*/
 
 // EditMenu provides a MenuItem with the whole default "Edit" menu (Undo, Copy, etc.).
 func EditMenu() *MenuItem {
-	return &MenuItem{
-		Role: EditMenuRole,
-	}
+	return roleMenuItem(EditMenuRole)
 }
 
-/*
-// ViewMenu provides a MenuItem with the whole default "View" menu (Reload, Toggle Developer Tools, etc.)
+// ViewMenu provides a MenuItem with the whole default "View" menu (Reload, Toggle Full Screen)
 func ViewMenu() *MenuItem {
-	return &MenuItem{
-		Role: ViewMenuRole,
-	}
+	return roleMenuItem(ViewMenuRole)
 }
-*/
 
 // WindowMenu provides a MenuItem with the whole default "Window" menu (Minimize, Zoom, etc.).
 // On MacOS currently all options in there won't work if the window is frameless.
 func WindowMenu() *MenuItem {
-	return &MenuItem{
-		Role: WindowMenuRole,
-	}
+	return roleMenuItem(WindowMenuRole)
+}
+
+// HelpMenu provides a MenuItem with the whole default "Help" menu (About)
+func HelpMenu() *MenuItem {
+	return roleMenuItem(HelpMenuRole)
 }
 
 // These roles are Mac only
 
 // AppMenu provides a MenuItem with the whole default "App" menu (About, Services, etc.)
 func AppMenu() *MenuItem {
-	return &MenuItem{
-		Role: AppMenuRole,
-	}
+	return roleMenuItem(AppMenuRole)
 }
 
-/*
 // Hide provides a MenuItem that maps to the hide action.
 func Hide() *MenuItem {
-	return &MenuItem{
-		Role: HideRole,
-	}
+	return roleMenuItem(HideRole)
 }
 
 // HideOthers provides a MenuItem that maps to the hideOtherApplications action.
 func HideOthers() *MenuItem {
-	return &MenuItem{
-		Role: HideOthersRole,
-	}
+	return roleMenuItem(HideOthersRole)
 }
 
 // UnHide provides a MenuItem that maps to the unHideAllApplications action.
 func UnHide() *MenuItem {
-	return &MenuItem{
-		Role: UnhideRole,
-	}
+	return roleMenuItem(UnhideRole)
 }
 
 // Front provides a MenuItem that maps to the arrangeInFront action.
 func Front() *MenuItem {
-	return &MenuItem{
-		Role: FrontRole,
-	}
-}
-
-// Zoom provides a MenuItem that maps to the performZoom action.
-func Zoom() *MenuItem {
-	return &MenuItem{
-		Role: ZoomRole,
-	}
-}
-
-// WindowSubMenu provides a MenuItem with the "Window" submenu.
-func WindowSubMenu() *MenuItem {
-	return &MenuItem{
-		Role: WindowSubMenuRole,
-	}
-}
-
-// HelpSubMenu provides a MenuItem with the "Help" submenu.
-func HelpSubMenu() *MenuItem {
-	return &MenuItem{
-		Role: HelpSubMenuRole,
-	}
+	return roleMenuItem(FrontRole)
 }
-*/
@@ -0,0 +1,73 @@
+package menu
+
+import (
+	"testing"
+
+	"github.com/matryer/is"
+	"github.com/wailsapp/wails/v2/pkg/menu/keys"
+)
+
+func roles(menu *Menu) []Role {
+	var result []Role
+	for _, item := range menu.Items {
+		result = append(result, item.Role)
+	}
+	return result
+}
+
+func TestRoleMenu(t *testing.T) {
+	defer func(platform string) { goos = platform }(goos)
+
+	tests := []struct {
+		platform string
+		role     Role
+		want     []Role
+	}{
+		{"linux", FileMenuRole, []Role{QuitRole}},
+		{"darwin", FileMenuRole, []Role{CloseWindowRole}},
+		{"linux", EditMenuRole, []Role{UndoRole, RedoRole, 0, CutRole, CopyRole, PasteRole, DeleteRole, 0, SelectAllRole}},
+		{"darwin", EditMenuRole, []Role{UndoRole, RedoRole, 0, CutRole, CopyRole, PasteRole, PasteAndMatchStyleRole, DeleteRole, 0, SelectAllRole}},
+		{"windows", ViewMenuRole, []Role{ReloadRole, 0, ToggleFullscreenRole}},
+		{"windows", WindowMenuRole, []Role{MinimizeRole, ZoomRole, CloseWindowRole}},
+		{"darwin", WindowMenuRole, []Role{MinimizeRole, ZoomRole, 0, FrontRole}},
+		{"linux", HelpMenuRole, []Role{AboutRole}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.platform+"/"+tt.role.Label(), func(t *testing.T) {
+			is2 := is.New(t)
+			goos = tt.platform
+			is2.True(tt.role.IsMenu())
+			is2.Equal(roles(tt.role.Menu()), tt.want)
+		})
+	}
+
+	is2 := is.New(t)
+	is2.True(AppMenuRole.Menu() == nil)
+	is2.True(CopyRole.Menu() == nil)
+	is2.True(!CopyRole.IsMenu())
+}
+
+func TestRoleDefaults(t *testing.T) {
+	defer func(platform string) { goos = platform }(goos)
+	is2 := is.New(t)
+
+	goos = "windows"
+	is2.Equal(QuitRole.Label(), "Exit")
+	is2.True(QuitRole.Accelerator() == nil)
+	is2.Equal(keys.Stringify(RedoRole.Accelerator(), goos), "Ctrl+Y")
+	is2.Equal(keys.Stringify(ToggleFullscreenRole.Accelerator(), goos), "F11")
+
+	goos = "linux"
+	is2.Equal(QuitRole.Label(), "Quit")
+	is2.Equal(keys.Stringify(QuitRole.Accelerator(), goos), "Ctrl+Q")
+	is2.Equal(keys.Stringify(RedoRole.Accelerator(), goos), "Ctrl+Shift+Z")
+
+	goos = "darwin"
+	is2.Equal(MinimizeRole.Label(), "Minimize")
+	is2.Equal(keys.Stringify(ToggleFullscreenRole.Accelerator(), goos), "Cmd+Ctrl+F")
+
+	// Every role has a label, except the Mac application menu which uses the application name
+	for role := Role(EditMenuRole); role <= FrontRole; role++ {
+		is2.True(role.Label() != "")
+	}
+}
@@ -0,0 +1,25 @@
+package menu
+
+// RadioGroups returns the radio groups of the menu and its submenus.
+// A radio group is a run of consecutive radio items in the same menu.
+func (m *Menu) RadioGroups() [][]*MenuItem {
+	var result [][]*MenuItem
+	var current []*MenuItem
+	for _, item := range m.Items {
+		if item.Type == RadioType {
+			current = append(current, item)
+			continue
+		}
+		if len(current) > 0 {
+			result = append(result, current)
+			current = nil
+		}
+		if item.SubMenu != nil {
+			result = append(result, item.SubMenu.RadioGroups()...)
+		}
+	}
+	if len(current) > 0 {
+		result = append(result, current)
+	}
+	return result
+}
@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"io/fs"
 	"net/http"
+	"path"
+	"strings"
 )
 
 // Options defines the configuration of the AssetServer.
@@ -33,6 +35,94 @@ type Options struct {
 	// Multiple Middlewares can be chained together with:
 	//   ChainMiddleware(middleware ...Middleware) Middleware
 	Middleware Middleware
+
+	// SecurityHeaders defines the security headers set on every response of the AssetServer. The scripts injected by
+	// Wails into `index.html` are allowed by the Content-Security-Policy with a nonce.
+	//
+	// If not defined, no security headers are set.
+	SecurityHeaders *SecurityHeaders
+
+	// SPAFallback serves the processed `index.html` with the injected runtime for navigation requests that can't be
+	// served from Assets or Handler. This allows client-side routers using the HTML5 history API to handle paths like
+	// `/settings/profile`. Requests for paths with a file extension are never served the fallback.
+	//
+	// If not defined, such requests result in `http.StatusNotFound`.
+	SPAFallback *SPAFallback
+
+	// CacheControl defines the `Cache-Control` header of assets served from Assets by request path. The first matching
+	// rule is used. Assets served from Assets without a modification time, EG: from an `embed.FS`, get a content hash
+	// `ETag` so they can be revalidated.
+	//
+	// If no rule matches, no `Cache-Control` header is set. The rules are not used in dev mode when serving assets
+	// from disk, where assets are never cached.
+	CacheControl []CacheControlRule
+
+	// FileRoutes serve files from directories outside of Assets, EG: the images of a photo browser. Requests for
+	// paths starting with the Prefix of a route are served from the route's Root or Dir. Paths that try to escape the
+	// root are rejected.
+	//
+	// To serve individual files outside of the routes, use `runtime.SignFileURL`.
+	FileRoutes []FileRoute
+
+	// SignedFileURLs serves the files of the URLs returned by `runtime.SignFileURL` under "/wails/file/". If false,
+	// these paths are served like any other path and `runtime.SignFileURL` returns an error.
+	SignedFileURLs bool
+}
+
+// FileRoute maps request paths starting with Prefix to the files of a directory.
+type FileRoute struct {
+	// Prefix of the request paths served by the route, EG: "/userfiles/"
+	Prefix string
+
+	// Root is the fs.FS the files are served from. Either Root or Dir must be set.
+	Root fs.FS
+
+	// Dir is the directory the files are served from. Either Root or Dir must be set.
+	Dir string
+
+	// AllowList are `path.Match` patterns of the paths relative to the root that may be served, EG: "*.jpg". A
+	// pattern ending in "/*" also matches all paths below that directory.
+	//
+	// If empty, all files may be served.
+	AllowList []string
+
+	// FollowSymlinks allows symlinks in Dir that point outside of Dir. Otherwise requests for those files result in
+	// `http.StatusNotFound`. Symlinks within Dir are always followed.
+	FollowSymlinks bool
+}
+
+// CacheControlRule sets the `Cache-Control` header of assets matching a path pattern.
+type CacheControlRule struct {
+	// Pattern is a `path.Match` pattern of request paths, EG: "/assets/*". A pattern ending in "/*" also matches all
+	// paths below that directory, so "/*" matches every path.
+	Pattern string
+
+	// Value of the `Cache-Control` header, EG: "public, max-age=31536000, immutable"
+	Value string
+}
+
+// SPAFallback defines how navigation requests of single page applications are handled.
+type SPAFallback struct {
+	// Path is the page served for navigation requests that don't match an asset. Default "/index.html"
+	Path string
+
+	// Exclude are `path.Match` patterns of request paths that are never served the fallback, EG: "/api/*".
+	// A pattern ending in "/*" also matches all paths below that directory.
+	Exclude []string
+}
+
+// SecurityHeaders defines the security headers set on every response of the AssetServer.
+type SecurityHeaders struct {
+	// CSP is the Content-Security-Policy, EG: "default-src 'self'". A nonce for the scripts injected by Wails is
+	// added to the `script-src` directive. If there is no `script-src` directive, one is added based on the
+	// `default-src` directive.
+	CSP string
+
+	// PermissionsPolicy is the Permissions-Policy, EG: "camera=(), microphone=()"
+	PermissionsPolicy string
+
+	// ReferrerPolicy is the Referrer-Policy, EG: "no-referrer"
+	ReferrerPolicy string
 }
 
 // Validate the options
@@ -41,5 +131,36 @@ func (o Options) Validate() error {
 		return fmt.Errorf("AssetServer options invalid: either Assets, Handler or Middleware must be set")
 	}
 
+	for _, rule := range o.CacheControl {
+		if _, err := path.Match(rule.Pattern, ""); err != nil {
+			return fmt.Errorf("AssetServer options invalid: CacheControl pattern '%s': %w", rule.Pattern, err)
+		}
+	}
+
+	for _, route := range o.FileRoutes {
+		if !strings.HasPrefix(route.Prefix, "/") || !strings.HasSuffix(route.Prefix, "/") {
+			return fmt.Errorf("AssetServer options invalid: FileRoute prefix '%s' must start and end with '/'", route.Prefix)
+		}
+		if (route.Root == nil) == (route.Dir == "") {
+			return fmt.Errorf("AssetServer options invalid: FileRoute '%s' must set either Root or Dir", route.Prefix)
+		}
+		for _, pattern := range route.AllowList {
+			if _, err := path.Match(pattern, ""); err != nil {
+				return fmt.Errorf("AssetServer options invalid: FileRoute '%s' AllowList pattern '%s': %w", route.Prefix, pattern, err)
+			}
+		}
+	}
+
+	if fallback := o.SPAFallback; fallback != nil {
+		if fallback.Path != "" && !strings.HasPrefix(fallback.Path, "/") {
+			return fmt.Errorf("AssetServer options invalid: SPAFallback.Path '%s' must start with '/'", fallback.Path)
+		}
+		for _, pattern := range fallback.Exclude {
+			if _, err := path.Match(pattern, ""); err != nil {
+				return fmt.Errorf("AssetServer options invalid: SPAFallback.Exclude pattern '%s': %w", pattern, err)
+			}
+		}
+	}
+
 	return nil
 }
@@ -0,0 +1,23 @@
+package options
+
+import "github.com/wailsapp/wails/v2/pkg/crashreport"
+
+// CrashReporter configures the crash reports written when the application panics or
+// the WebView process ends unexpectedly
+type CrashReporter struct {
+	// Dir is the directory crash reports are written to.
+	// Defaults to a "crashes" directory in the per-user log directory of the application.
+	Dir string
+
+	// OnCrash is called after a crash report has been written. The application exits
+	// afterwards unless the WebView recovered from the crash.
+	OnCrash func(report crashreport.Report)
+
+	// UploadHandler sends a crash report, EG: to an issue tracker. If set, on the next
+	// launch the user is asked whether to send the reports of previous crashes. Otherwise
+	// they are offered to view the latest report.
+	UploadHandler func(report crashreport.Report) error
+
+	// RecentLogs is the number of recent logs included in a crash report. Default: 200
+	RecentLogs int
+}
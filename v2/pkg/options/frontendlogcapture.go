@@ -0,0 +1,40 @@
+package options
+
+import "github.com/wailsapp/wails/v2/pkg/logger"
+
+// FrontendLogCapture configures the forwarding of frontend console output, uncaught
+// errors and unhandled promise rejections to the application log
+type FrontendLogCapture struct {
+	// MinLevel is the minimum level of frontend logs captured in debug builds. Default: Debug
+	MinLevel logger.LogLevel
+
+	// MinLevelProduction is the minimum level of frontend logs captured in production builds. Default: Warning
+	MinLevelProduction logger.LogLevel
+
+	// RateLimit is the maximum number of frontend logs forwarded per second. Default: 20
+	RateLimit int
+}
+
+// Level returns the minimum level captured in debug or production builds
+func (c *FrontendLogCapture) Level(debug bool) logger.LogLevel {
+	if debug {
+		return c.MinLevel
+	}
+	return c.MinLevelProduction
+}
+
+func processFrontendLogCapture(appoptions *App) {
+	capture := appoptions.CaptureFrontendLogs
+	if capture == nil {
+		return
+	}
+	if capture.MinLevel == 0 {
+		capture.MinLevel = logger.DEBUG
+	}
+	if capture.MinLevelProduction == 0 {
+		capture.MinLevelProduction = logger.WARNING
+	}
+	if capture.RateLimit <= 0 {
+		capture.RateLimit = 20
+	}
+}
@@ -67,6 +67,22 @@ type App struct {
 	// ErrorFormatter overrides the formatting of errors returned by backend methods
 	ErrorFormatter ErrorFormatter
 
+	// SlogHandler receives all logs as structured records. If set, Logger is not used.
+	// Use logger.NewSlogHandler to send structured logs to a Logger. Requires Go 1.21.
+	SlogHandler logger.SlogHandler `json:"-"`
+
+	// CaptureFrontendLogs forwards console output, uncaught errors and unhandled promise
+	// rejections of the frontend to the log. Disabled if nil.
+	CaptureFrontendLogs *FrontendLogCapture
+
+	// CrashReporter writes a crash report when the application panics or the WebView process
+	// ends unexpectedly. Disabled if nil.
+	CrashReporter *CrashReporter
+
+	// WindowState saves the size, position and state of the window when the application quits
+	// and restores them on the next launch. Disabled if nil.
+	WindowState *WindowState
+
 	// CSS property to test for draggable elements. Default "--wails-draggable"
 	CSSDragProperty string
 
@@ -77,6 +93,16 @@ type App struct {
 	// This menu is already enabled in development and debug builds
 	EnableDefaultContextMenu bool
 
+	// ContextMenus are native context menus. A context menu is opened when an element with the
+	// CSS property `--wails-contextmenu: <id>` is right-clicked.
+	ContextMenus []*menu.ContextMenu
+
+	// KeyBindings are keyboard shortcuts handled in the WebView, which work without a menu.
+	// The keys use the keys.Parse syntax, EG: "CmdOrCtrl+Shift+P". The default action of the keys
+	// is prevented and they are ignored when an input has focus. Use runtime.KeyBindingAdd to
+	// configure this or to add key bindings while the application is running.
+	KeyBindings map[string]func(ctx context.Context) `json:"-"`
+
 	// EnableFraudulentWebsiteDetection enables scan services for fraudulent content, such as malware or phishing attempts.
 	// These services might send information from your app like URLs navigated to and possibly other content to cloud
 	// services of Apple and Microsoft.
@@ -163,6 +189,9 @@ func MergeDefaults(appoptions *App) {
 
 	// Process Drag Options
 	processDragOptions(appoptions)
+
+	// Frontend log capture defaults
+	processFrontendLogCapture(appoptions)
 }
 
 func processMenus(appoptions *App) {
@@ -0,0 +1,17 @@
+package options
+
+import "github.com/wailsapp/wails/v2/pkg/windowstate"
+
+// WindowState configures the saving of the size, position, maximised and fullscreen state
+// of the window when the application quits, so they can be restored on the next launch
+type WindowState struct {
+	// Persist saves the window state on quit and restores it on startup
+	Persist bool
+
+	// Key names the saved state, EG: to save a state per configuration. Default: "main"
+	Key string
+
+	// Store saves and loads the window state.
+	// Defaults to a windowstate.json file in the per-user config directory of the application.
+	Store windowstate.Store
+}
@@ -0,0 +1,59 @@
+package runtime
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/wailsapp/wails/v2/internal/frontend"
+	"github.com/wailsapp/wails/v2/internal/signedurl"
+)
+
+// AssetRequest describes a request served by the AssetServer
+type AssetRequest = frontend.AssetRequest
+
+// LatencyBucket counts the requests of a path within a latency range
+type LatencyBucket = frontend.LatencyBucket
+
+// AssetPathStats are the statistics of the requests for a path
+type AssetPathStats = frontend.AssetPathStats
+
+// AssetServerStatistics are the statistics of the requests served by the AssetServer
+type AssetServerStatistics = frontend.AssetServerStats
+
+// Sources an AssetRequest is served from
+const (
+	ServedFromFS        = frontend.ServedFromFS
+	ServedFromHandler   = frontend.ServedFromHandler
+	ServedFromProxy     = frontend.ServedFromProxy
+	ServedFromRuntime   = frontend.ServedFromRuntime
+	ServedFromFileRoute = frontend.ServedFromFileRoute
+)
+
+// SignFileURL returns a URL that serves the given file from the AssetServer until the expiry has passed,
+// EG: to show an image outside of the configured FileRoutes. URLs are only valid while the application runs.
+func SignFileURL(ctx context.Context, filename string, expiry time.Duration) (string, error) {
+	if ctx == nil {
+		log.Fatalf("Error calling 'runtime.SignFileURL': %s", contextError)
+	}
+	if enabled, _ := ctx.Value("signedfileurls").(bool); !enabled {
+		return "", errors.New("signed file URLs are not enabled: set AssetServer.SignedFileURLs in the application options")
+	}
+	return signedurl.Sign(filename, expiry)
+}
+
+// AssetServerStats returns the most recent requests served by the AssetServer and a latency histogram
+// for each path. Requests are only recorded in dev and debug builds.
+func AssetServerStats(ctx context.Context) AssetServerStatistics {
+	if ctx == nil {
+		log.Fatalf("Error calling 'runtime.AssetServerStats': %s", contextError)
+	}
+	inspector, _ := ctx.Value("assetinspector").(interface {
+		Stats() frontend.AssetServerStats
+	})
+	if inspector == nil {
+		return AssetServerStatistics{}
+	}
+	return inspector.Stats()
+}
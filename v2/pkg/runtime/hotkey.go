@@ -0,0 +1,28 @@
+package runtime
+
+import (
+	"context"
+
+	"github.com/wailsapp/wails/v2/internal/frontend"
+	"github.com/wailsapp/wails/v2/pkg/menu/keys"
+)
+
+var (
+	// ErrHotkeyInUse is returned when a hotkey is already registered by this or another application
+	ErrHotkeyInUse = frontend.ErrHotkeyInUse
+	// ErrHotkeysNotSupported is returned when global hotkeys are not supported on the platform
+	ErrHotkeysNotSupported = frontend.ErrHotkeysNotSupported
+)
+
+// HotkeyRegister registers a system-wide hotkey that calls the callback when pressed, even if the
+// application does not have focus. It returns an ID that can be used to unregister the hotkey.
+func HotkeyRegister(ctx context.Context, accelerator *keys.Accelerator, callback func()) (int, error) {
+	appFrontend := getFrontend(ctx)
+	return appFrontend.HotkeyRegister(accelerator, callback)
+}
+
+// HotkeyUnregister unregisters the hotkey with the given ID
+func HotkeyUnregister(ctx context.Context, id int) error {
+	appFrontend := getFrontend(ctx)
+	return appFrontend.HotkeyUnregister(id)
+}
@@ -0,0 +1,32 @@
+package runtime
+
+import (
+	"context"
+	"log"
+
+	"github.com/wailsapp/wails/v2/internal/keybindings"
+)
+
+// KeyBinding is a keyboard shortcut that is handled by the runtime in the WebView
+type KeyBinding = keybindings.KeyBinding
+
+func getKeyBindings(ctx context.Context, funcName string) *keybindings.Manager {
+	if ctx == nil {
+		log.Fatalf("Error calling 'runtime.%s': %s", funcName, contextError)
+	}
+	result, _ := ctx.Value("keybindings").(*keybindings.Manager)
+	if result == nil {
+		log.Fatalf("Error calling 'runtime.%s': %s", funcName, contextError)
+	}
+	return result
+}
+
+// KeyBindingAdd adds a key binding, replacing any key binding for the same keys
+func KeyBindingAdd(ctx context.Context, keyBinding *KeyBinding) error {
+	return getKeyBindings(ctx, "KeyBindingAdd").Add(keyBinding)
+}
+
+// KeyBindingRemove removes the key binding for the accelerator, EG: "CmdOrCtrl+Shift+P"
+func KeyBindingRemove(ctx context.Context, accelerator string) error {
+	return getKeyBindings(ctx, "KeyBindingRemove").Remove(accelerator)
+}
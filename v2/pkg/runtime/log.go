@@ -3,6 +3,8 @@ package runtime
 import (
 	"context"
 	"fmt"
+
+	internalLogger "github.com/wailsapp/wails/v2/internal/logger"
 	"github.com/wailsapp/wails/v2/pkg/logger"
 )
 
@@ -97,6 +99,42 @@ func LogFatalf(ctx context.Context, format string, args ...interface{}) {
 	myLogger.Fatal(msg)
 }
 
+// LogTraceAttrs prints a Trace level message with the given key/value attributes
+func LogTraceAttrs(ctx context.Context, message string, args ...any) {
+	myLogger := getLogger(ctx)
+	myLogger.Structured().Log(ctx, logger.TRACE, message, args...)
+}
+
+// LogDebugAttrs prints a Debug level message with the given key/value attributes
+func LogDebugAttrs(ctx context.Context, message string, args ...any) {
+	myLogger := getLogger(ctx)
+	myLogger.Structured().Log(ctx, logger.DEBUG, message, args...)
+}
+
+// LogInfoAttrs prints an Info level message with the given key/value attributes
+func LogInfoAttrs(ctx context.Context, message string, args ...any) {
+	myLogger := getLogger(ctx)
+	myLogger.Structured().Log(ctx, logger.INFO, message, args...)
+}
+
+// LogWarningAttrs prints a Warning level message with the given key/value attributes
+func LogWarningAttrs(ctx context.Context, message string, args ...any) {
+	myLogger := getLogger(ctx)
+	myLogger.Structured().Log(ctx, logger.WARNING, message, args...)
+}
+
+// LogErrorAttrs prints an Error level message with the given key/value attributes
+func LogErrorAttrs(ctx context.Context, message string, args ...any) {
+	myLogger := getLogger(ctx)
+	myLogger.Structured().Log(ctx, logger.ERROR, message, args...)
+}
+
+// LogFatalAttrs prints a Fatal level message with the given key/value attributes
+func LogFatalAttrs(ctx context.Context, message string, args ...any) {
+	myLogger := getLogger(ctx)
+	myLogger.Structured().Log(ctx, internalLogger.FATAL, message, args...)
+}
+
 // LogSetLogLevel sets the log level
 func LogSetLogLevel(ctx context.Context, level logger.LogLevel) {
 	myLogger := getLogger(ctx)
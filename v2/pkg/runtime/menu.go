@@ -2,15 +2,33 @@ package runtime
 
 import (
 	"context"
+
+	"github.com/wailsapp/wails/v2/internal/menumanager"
 	"github.com/wailsapp/wails/v2/pkg/menu"
 )
 
+// getMenuManager returns the menu manager of the application or nil if there is none, EG: in tests
+func getMenuManager(ctx context.Context) *menumanager.Manager {
+	result, _ := ctx.Value("menumanager").(*menumanager.Manager)
+	return result
+}
+
 func MenuSetApplicationMenu(ctx context.Context, menu *menu.Menu) {
 	frontend := getFrontend(ctx)
+	if menuManager := getMenuManager(ctx); menuManager != nil {
+		if err := menuManager.SetApplicationMenu(menu); err != nil {
+			getLogger(ctx).Error("unable to process the application menu: %s", err)
+		}
+	}
 	frontend.MenuSetApplicationMenu(menu)
 }
 
 func MenuUpdateApplicationMenu(ctx context.Context) {
 	frontend := getFrontend(ctx)
+	if menuManager := getMenuManager(ctx); menuManager != nil {
+		if _, err := menuManager.UpdateApplicationMenu(); err != nil {
+			getLogger(ctx).Error("unable to process the application menu: %s", err)
+		}
+	}
 	frontend.MenuUpdateApplicationMenu()
 }
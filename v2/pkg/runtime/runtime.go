@@ -5,6 +5,7 @@ import (
 	"log"
 	goruntime "runtime"
 
+	"github.com/wailsapp/wails/v2/internal/buildinfo"
 	"github.com/wailsapp/wails/v2/internal/frontend"
 	"github.com/wailsapp/wails/v2/internal/logger"
 )
@@ -104,3 +105,12 @@ func Environment(ctx context.Context) EnvironmentInfo {
 	result.Arch = goruntime.GOARCH
 	return result
 }
+
+// BuildInfo contains information about how the application was built
+type BuildInfo = buildinfo.Info
+
+// ReadBuildInfo returns the build information embedded by the Wails CLI.
+// All fields are empty if the application was not built using the Wails CLI.
+func ReadBuildInfo(_ context.Context) BuildInfo {
+	return buildinfo.Get()
+}
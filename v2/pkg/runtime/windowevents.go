@@ -0,0 +1,54 @@
+package runtime
+
+import (
+	"context"
+
+	"github.com/wailsapp/wails/v2/internal/frontend"
+)
+
+// WindowEventKind is the name of an event emitted when the main window changes, EG: WindowEventResize
+type WindowEventKind = frontend.WindowEventKind
+
+// WindowSize is the payload of WindowEventResize events
+type WindowSize = frontend.WindowSize
+
+// WindowPosition is the payload of WindowEventMove events
+type WindowPosition = frontend.WindowPosition
+
+// The kinds of window events
+const (
+	WindowEventResize     = frontend.WindowEventResize
+	WindowEventMove       = frontend.WindowEventMove
+	WindowEventFocus      = frontend.WindowEventFocus
+	WindowEventBlur       = frontend.WindowEventBlur
+	WindowEventMinimise   = frontend.WindowEventMinimise
+	WindowEventMaximise   = frontend.WindowEventMaximise
+	WindowEventFullscreen = frontend.WindowEventFullscreen
+	WindowEventRestore    = frontend.WindowEventRestore
+)
+
+// WindowEvent is a change of the main window
+type WindowEvent struct {
+	Kind WindowEventKind
+	// Size of the window for WindowEventResize events
+	Size *WindowSize
+	// Position of the window for WindowEventMove events
+	Position *WindowPosition
+}
+
+// OnWindowEvent registers a listener for the given kind of window event. It returns a function to cancel the listener
+func OnWindowEvent(ctx context.Context, kind WindowEventKind, callback func(event *WindowEvent)) func() {
+	events := getEvents(ctx)
+	return events.On(string(kind), func(optionalData ...interface{}) {
+		event := &WindowEvent{Kind: kind}
+		if len(optionalData) > 0 {
+			switch data := optionalData[0].(type) {
+			case WindowSize:
+				event.Size = &data
+			case WindowPosition:
+				event.Position = &data
+			}
+		}
+		callback(event)
+	})
+}
@@ -0,0 +1,123 @@
+// Package windowstate defines the window state that is saved when the application quits
+// and restored on the next launch, and the stores it is saved in.
+package windowstate
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// Filename is the name of the file the FileStore saves the window states in
+const Filename = "windowstate.json"
+
+// Screen identifies a screen by its size in logical pixels and whether it is the primary screen
+type Screen struct {
+	Width     int  `json:"width"`
+	Height    int  `json:"height"`
+	IsPrimary bool `json:"isPrimary"`
+}
+
+// State is the saved state of a window
+type State struct {
+	// The size and position of the window when it is neither maximised nor fullscreen.
+	// The position is relative to the top left corner of the primary screen, whichever screen
+	// the window is on. Unlike runtime.WindowGetPosition, it does not depend on the work area
+	// of the screen.
+	Width  int `json:"width"`
+	Height int `json:"height"`
+	X      int `json:"x"`
+	Y      int `json:"y"`
+
+	Maximised  bool `json:"maximised"`
+	Fullscreen bool `json:"fullscreen"`
+
+	// Screen is the screen the window was on
+	Screen Screen `json:"screen"`
+	// Screens are all the screens at the time the state was saved
+	Screens []Screen `json:"screens"`
+}
+
+// Store saves and loads window states by key
+type Store interface {
+	// Load returns the state saved for the key, or nil if there is none
+	Load(key string) (*State, error)
+	// Save saves the state for the key
+	Save(key string, state *State) error
+}
+
+// FileStore saves the window states of an application in a JSON file
+type FileStore struct {
+	// Dir is the directory of the windowstate.json file
+	Dir string
+
+	lock sync.Mutex
+}
+
+// NewFileStore creates a FileStore that saves the window states in the given directory
+func NewFileStore(dir string) *FileStore {
+	return &FileStore{Dir: dir}
+}
+
+// DefaultDirectory returns the per-user config directory of the application
+func DefaultDirectory(appName string) (string, error) {
+	configDir, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(configDir, appName), nil
+}
+
+// Load returns the state saved for the key, or nil if there is none
+func (s *FileStore) Load(key string) (*State, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	states, err := s.read()
+	if err != nil {
+		return nil, err
+	}
+	return states[key], nil
+}
+
+// Save saves the state for the key, keeping the states saved for other keys
+func (s *FileStore) Save(key string, state *State) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	states, err := s.read()
+	if err != nil {
+		// Replace a corrupt file
+		states = map[string]*State{}
+	}
+	states[key] = state
+
+	data, err := json.MarshalIndent(states, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(s.Dir, 0755); err != nil {
+		return err
+	}
+	// Write to a temporary file first so that the states are not lost if writing fails
+	filename := filepath.Join(s.Dir, Filename)
+	if err := os.WriteFile(filename+".tmp", data, 0644); err != nil {
+		return err
+	}
+	return os.Rename(filename+".tmp", filename)
+}
+
+func (s *FileStore) read() (map[string]*State, error) {
+	data, err := os.ReadFile(filepath.Join(s.Dir, Filename))
+	if errors.Is(err, os.ErrNotExist) {
+		return map[string]*State{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	states := map[string]*State{}
+	if err := json.Unmarshal(data, &states); err != nil {
+		return nil, err
+	}
+	return states, nil
+}
@@ -0,0 +1,55 @@
+package windowstate
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/matryer/is"
+)
+
+func TestFileStore(t *testing.T) {
+	is2 := is.New(t)
+
+	store := NewFileStore(filepath.Join(t.TempDir(), "app"))
+
+	state, err := store.Load("main")
+	is2.NoErr(err)
+	is2.Equal(state, nil)
+
+	main := &State{
+		Width:     1024,
+		Height:    768,
+		X:         100,
+		Y:         50,
+		Maximised: true,
+		Screen:    Screen{Width: 1920, Height: 1080, IsPrimary: true},
+		Screens:   []Screen{{Width: 1920, Height: 1080, IsPrimary: true}},
+	}
+	is2.NoErr(store.Save("main", main))
+	is2.NoErr(store.Save("settings", &State{Width: 400, Height: 300}))
+
+	state, err = store.Load("main")
+	is2.NoErr(err)
+	is2.Equal(state, main)
+
+	state, err = store.Load("settings")
+	is2.NoErr(err)
+	is2.Equal(state.Width, 400)
+}
+
+func TestFileStore_Corrupt(t *testing.T) {
+	is2 := is.New(t)
+
+	dir := t.TempDir()
+	is2.NoErr(os.WriteFile(filepath.Join(dir, Filename), []byte("{"), 0644))
+	store := NewFileStore(dir)
+
+	_, err := store.Load("main")
+	is2.True(err != nil)
+
+	is2.NoErr(store.Save("main", &State{Width: 800, Height: 600}))
+	state, err := store.Load("main")
+	is2.NoErr(err)
+	is2.Equal(state.Width, 800)
+}